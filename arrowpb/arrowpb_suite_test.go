@@ -0,0 +1,15 @@
+package arrowpb
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Create a new test runner we'll use to test all the
+// modules in the arrowpb package
+func TestArrowpb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Arrowpb Suite")
+}
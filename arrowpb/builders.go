@@ -0,0 +1,251 @@
+package arrowpb
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/apache/arrow/go/v11/arrow/array"
+	"github.com/apache/arrow/go/v11/arrow/decimal128"
+	"github.com/apache/arrow/go/v11/arrow/decimal256"
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/gopb"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// DecimalBuilder appends Decimal values to an Arrow decimal128 or decimal256 builder
+type DecimalBuilder struct {
+	builder   array.Builder
+	precision int32
+	scale     int32
+}
+
+// NewDecimalBuilder creates a new DecimalBuilder that appends to the Arrow builder provided, which may be
+// a field of an array.RecordBuilder. An error will be returned if the builder is not a decimal builder
+func NewDecimalBuilder(builder array.Builder) (*DecimalBuilder, error) {
+	switch dtype := builder.Type().(type) {
+	case *arrow.Decimal128Type:
+		return &DecimalBuilder{builder: builder, precision: dtype.Precision, scale: dtype.Scale}, nil
+	case *arrow.Decimal256Type:
+		return &DecimalBuilder{builder: builder, precision: dtype.Precision, scale: dtype.Scale}, nil
+	default:
+		return nil, typeError("Arrow builder", builder.Type(), "Decimal")
+	}
+}
+
+// Append adds a Decimal to the builder. A nil Decimal will be appended as null. An error wrapping
+// utils.ErrPrecisionLoss will be returned if the value has more decimal places than the scale of the
+// builder, and a utils.RangeError will be returned if it has more digits than the precision allows
+func (b *DecimalBuilder) Append(value *gopb.Decimal) error {
+
+	// First, if the value is nil then append a null
+	if value == nil {
+		b.builder.AppendNull()
+		return nil
+	}
+
+	// Next, shift the value by the scale so that it can be stored as an integer. If this leaves a
+	// fractional part then the value cannot be stored without losing precision
+	scaled := value.ToDecimal().Shift(b.scale)
+	if !scaled.IsInteger() {
+		return fmt.Errorf("Decimal (%s) has more than %d decimal places: %w",
+			value.ToString(), b.scale, utils.ErrPrecisionLoss)
+	}
+
+	// Now, check that the value has no more digits than the precision allows
+	coefficient := scaled.BigInt()
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(b.precision)), nil)
+	if new(big.Int).Abs(coefficient).Cmp(limit) >= 0 {
+		bound := decimal.NewFromBigInt(limit.Sub(limit, big.NewInt(1)), -b.scale)
+		if coefficient.Sign() < 0 {
+			return &utils.RangeError{Type: "Decimal", Value: value.ToString(), Bound: bound.Neg().String(),
+				Err: utils.ErrUnderflow}
+		}
+
+		return &utils.RangeError{Type: "Decimal", Value: value.ToString(), Bound: bound.String(),
+			Err: utils.ErrOverflow}
+	}
+
+	// Finally, append the value to the underlying builder
+	switch builder := b.builder.(type) {
+	case *array.Decimal128Builder:
+		builder.Append(decimal128.FromBigInt(coefficient))
+	case *array.Decimal256Builder:
+		builder.Append(decimal256.FromBigInt(coefficient))
+	}
+
+	return nil
+}
+
+// AppendNull adds a null value to the builder
+func (b *DecimalBuilder) AppendNull() {
+	b.builder.AppendNull()
+}
+
+// TimestampBuilder appends UnixTimestamp values to an Arrow timestamp builder
+type TimestampBuilder struct {
+	builder *array.TimestampBuilder
+	unit    arrow.TimeUnit
+}
+
+// NewTimestampBuilder creates a new TimestampBuilder that appends to the Arrow builder provided, which may
+// be a field of an array.RecordBuilder. An error will be returned if the builder is not a timestamp builder
+func NewTimestampBuilder(builder array.Builder) (*TimestampBuilder, error) {
+	casted, ok := builder.(*array.TimestampBuilder)
+	if !ok {
+		return nil, typeError("Arrow builder", builder.Type(), "UnixTimestamp")
+	}
+
+	return &TimestampBuilder{builder: casted, unit: casted.Type().(*arrow.TimestampType).Unit}, nil
+}
+
+// Append adds a UnixTimestamp to the builder. A nil UnixTimestamp will be appended as null. An error
+// wrapping utils.ErrPrecisionLoss will be returned if the timestamp is more precise than the unit of the
+// builder, and a utils.RangeError will be returned if it is invalid or cannot be represented in that unit
+func (b *TimestampBuilder) Append(value *gopb.UnixTimestamp) error {
+	if value == nil {
+		b.builder.AppendNull()
+		return nil
+	}
+
+	if err := value.CheckValid(); err != nil {
+		return err
+	}
+
+	units, err := toUnits(value.Seconds, int64(value.Nanoseconds), b.unit)
+	if err != nil {
+		return timeError("timestamp", value.Seconds, value.Nanoseconds, b.unit, err, func(limit int64) string {
+			return arrow.Timestamp(limit).ToTime(b.unit).Format(time.RFC3339Nano)
+		})
+	}
+
+	b.builder.Append(arrow.Timestamp(units))
+	return nil
+}
+
+// AppendNull adds a null value to the builder
+func (b *TimestampBuilder) AppendNull() {
+	b.builder.AppendNull()
+}
+
+// DurationBuilder appends UnixDuration values to an Arrow duration builder
+type DurationBuilder struct {
+	builder *array.DurationBuilder
+	unit    arrow.TimeUnit
+}
+
+// NewDurationBuilder creates a new DurationBuilder that appends to the Arrow builder provided, which may
+// be a field of an array.RecordBuilder. An error will be returned if the builder is not a duration builder
+func NewDurationBuilder(builder array.Builder) (*DurationBuilder, error) {
+	casted, ok := builder.(*array.DurationBuilder)
+	if !ok {
+		return nil, typeError("Arrow builder", builder.Type(), "UnixDuration")
+	}
+
+	return &DurationBuilder{builder: casted, unit: casted.Type().(*arrow.DurationType).Unit}, nil
+}
+
+// Append adds a UnixDuration to the builder. A nil UnixDuration will be appended as null. An error
+// wrapping utils.ErrPrecisionLoss will be returned if the duration is more precise than the unit of the
+// builder, and a utils.RangeError will be returned if it is invalid or cannot be represented in that unit
+func (b *DurationBuilder) Append(value *gopb.UnixDuration) error {
+	if value == nil {
+		b.builder.AppendNull()
+		return nil
+	}
+
+	if err := value.CheckValid(); err != nil {
+		return err
+	}
+
+	units, err := toUnits(value.Seconds, int64(value.Nanoseconds), b.unit)
+	if err != nil {
+		return timeError("duration", value.Seconds, value.Nanoseconds, b.unit, err, func(limit int64) string {
+			return fmt.Sprintf("%d%s", limit, b.unit)
+		})
+	}
+
+	b.builder.Append(arrow.Duration(units))
+	return nil
+}
+
+// AppendNull adds a null value to the builder
+func (b *DurationBuilder) AppendNull() {
+	b.builder.AppendNull()
+}
+
+// EnumBuilder appends enum values to an Arrow dictionary builder, as dictionary-encoded strings
+type EnumBuilder[T utils.ProtoEnum] struct {
+	builder *array.BinaryDictionaryBuilder
+}
+
+// NewEnumBuilder creates a new EnumBuilder that appends to the Arrow builder provided, which may be a field
+// of an array.RecordBuilder. An error will be returned if the builder is not a dictionary builder with
+// string values
+func NewEnumBuilder[T utils.ProtoEnum](builder array.Builder) (*EnumBuilder[T], error) {
+	casted, ok := builder.(*array.BinaryDictionaryBuilder)
+	if !ok || casted.Type().(*arrow.DictionaryType).ValueType.ID() != arrow.STRING {
+		var value T
+		return nil, typeError("Arrow builder", builder.Type(), fmt.Sprintf("%T", value))
+	}
+
+	return &EnumBuilder[T]{builder: casted}, nil
+}
+
+// Append adds an enum value to the builder, using the same name the value is written with in JSON. If
+// the value is equivalent to utils.NoValue then it will be appended as null
+func (b *EnumBuilder[T]) Append(value T) error {
+	if !utils.HasValue(value) {
+		b.builder.AppendNull()
+		return nil
+	}
+
+	return b.builder.AppendString(utils.Describe(value).OutputName)
+}
+
+// AppendNull adds a null value to the builder
+func (b *EnumBuilder[T]) AppendNull() {
+	b.builder.AppendNull()
+}
+
+// Helper function that converts seconds and nanoseconds to a number of time units. An error wrapping
+// utils.ErrPrecisionLoss will be returned if the nanoseconds are not a whole number of units, and
+// utils.ErrOverflow or utils.ErrUnderflow if the result does not fit in an int64
+func toUnits(seconds int64, nanos int64, unit arrow.TimeUnit) (int64, error) {
+	multiplier := int64(unit.Multiplier())
+	if nanos%multiplier != 0 {
+		return 0, utils.ErrPrecisionLoss
+	}
+
+	// The limits are the greatest and least number of seconds that can be represented, along with the
+	// remaining number of units that can be represented in addition to them
+	perSecond := int64(time.Second) / multiplier
+	fraction := nanos / multiplier
+	maxSeconds, maxFraction := int64(math.MaxInt64)/perSecond, int64(math.MaxInt64)%perSecond
+	minSeconds, minFraction := int64(math.MinInt64)/perSecond, int64(math.MinInt64)%perSecond
+	switch {
+	case seconds > maxSeconds || (seconds == maxSeconds && fraction > maxFraction):
+		return 0, utils.ErrOverflow
+	case seconds < minSeconds || (seconds == minSeconds && fraction < minFraction):
+		return 0, utils.ErrUnderflow
+	default:
+		return seconds*perSecond + fraction, nil
+	}
+}
+
+// Helper function that converts an error returned by toUnits to an error describing the value that could
+// not be converted. The bound function formats the limit of the unit, in units, for a range error
+func timeError(kind string, seconds int64, nanos int32, unit arrow.TimeUnit, err error,
+	bound func(int64) string) error {
+	value := fmt.Sprintf("%d, %d", seconds, nanos)
+	switch err {
+	case utils.ErrOverflow:
+		return &utils.RangeError{Type: kind, Value: value, Bound: bound(math.MaxInt64), Err: err}
+	case utils.ErrUnderflow:
+		return &utils.RangeError{Type: kind, Value: value, Bound: bound(math.MinInt64), Err: err}
+	default:
+		return fmt.Errorf("%s (%s) cannot be written in units of %s: %w", kind, value, unit, err)
+	}
+}
@@ -0,0 +1,347 @@
+package arrowpb
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/apache/arrow/go/v11/arrow/array"
+	"github.com/apache/arrow/go/v11/arrow/ipc"
+	"github.com/apache/arrow/go/v11/arrow/memory"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/gopb"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Arrow Type Tests", func() {
+
+	// Tests that the DecimalType function maps precisions to the correct Arrow type
+	DescribeTable("DecimalType - Works",
+		func(precision int32, expected arrow.Type) {
+			dtype, err := DecimalType(precision, 4)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(dtype.ID()).Should(Equal(expected))
+		},
+		Entry("Minimum precision - Decimal128", int32(1), arrow.DECIMAL128),
+		Entry("Maximum decimal128 precision - Decimal128", int32(38), arrow.DECIMAL128),
+		Entry("Minimum decimal256 precision - Decimal256", int32(39), arrow.DECIMAL256),
+		Entry("Maximum precision - Decimal256", int32(76), arrow.DECIMAL256))
+
+	// Tests that the DecimalType function returns an error if the precision is not supported by Arrow
+	DescribeTable("DecimalType - Invalid precision - Error",
+		func(precision int32, bound string, inner error) {
+			dtype, err := DecimalType(precision, 0)
+			Expect(dtype).Should(BeNil())
+
+			var rErr *utils.RangeError
+			Expect(errors.As(err, &rErr)).Should(BeTrue())
+			Expect(rErr.Bound).Should(Equal(bound))
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+		},
+		Entry("Zero - Underflow", int32(0), "1", utils.ErrUnderflow),
+		Entry("Greater than 76 - Overflow", int32(77), "76", utils.ErrOverflow))
+})
+
+var _ = Describe("Arrow IPC Tests", func() {
+
+	// Helper function that creates a schema containing each of the types that can be written to Arrow
+	newSchema := func(precision int32, scale int32) *arrow.Schema {
+		dtype, err := DecimalType(precision, scale)
+		Expect(err).ShouldNot(HaveOccurred())
+		return arrow.NewSchema([]arrow.Field{
+			{Name: "price", Type: dtype, Nullable: true},
+			{Name: "timestamp", Type: TimestampType, Nullable: true},
+			{Name: "latency", Type: DurationType, Nullable: true},
+			{Name: "class", Type: EnumType, Nullable: true},
+		}, nil)
+	}
+
+	// Helper function that writes a record to an IPC file and reads it back again
+	roundTrip := func(record arrow.Record) arrow.Record {
+		path := filepath.Join(GinkgoT().TempDir(), "ticks.arrow")
+		out, err := os.Create(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		writer, err := ipc.NewFileWriter(out, ipc.WithSchema(record.Schema()))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(writer.Write(record)).ShouldNot(HaveOccurred())
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+		Expect(out.Close()).ShouldNot(HaveOccurred())
+
+		in, err := os.Open(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(in.Close)
+
+		reader, err := ipc.NewFileReader(in)
+		Expect(err).ShouldNot(HaveOccurred())
+		DeferCleanup(reader.Close)
+		Expect(reader.NumRecords()).Should(Equal(1))
+
+		read, err := reader.Record(0)
+		Expect(err).ShouldNot(HaveOccurred())
+		return read
+	}
+
+	// Tests that records containing gopb types can be written to an IPC file and read back again
+	DescribeTable("Write, Read - Works",
+		func(precision int32, price string) {
+
+			// First, create the record builder and wrap each of its fields
+			builder := array.NewRecordBuilder(memory.DefaultAllocator, newSchema(precision, 3))
+			defer builder.Release()
+
+			prices, err := NewDecimalBuilder(builder.Field(0))
+			Expect(err).ShouldNot(HaveOccurred())
+			timestamps, err := NewTimestampBuilder(builder.Field(1))
+			Expect(err).ShouldNot(HaveOccurred())
+			latencies, err := NewDurationBuilder(builder.Field(2))
+			Expect(err).ShouldNot(HaveOccurred())
+			classes, err := NewEnumBuilder[gopb.Financial_Common_AssetClass](builder.Field(3))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// Next, append a row of values and a row of nulls
+			Expect(prices.Append(gopb.NewFromDecimal(mustParse(price)))).ShouldNot(HaveOccurred())
+			Expect(timestamps.Append(gopb.NewUnixTimestamp(1654127993, 983000001))).ShouldNot(HaveOccurred())
+			Expect(latencies.Append(gopb.NewUnixDuration(-90, -500000000))).ShouldNot(HaveOccurred())
+			Expect(classes.Append(gopb.Financial_Common_Crypto)).ShouldNot(HaveOccurred())
+
+			Expect(prices.Append(nil)).ShouldNot(HaveOccurred())
+			Expect(timestamps.Append(nil)).ShouldNot(HaveOccurred())
+			Expect(latencies.Append(nil)).ShouldNot(HaveOccurred())
+			Expect(classes.Append(utils.NoValue[gopb.Financial_Common_AssetClass]())).ShouldNot(HaveOccurred())
+
+			record := builder.NewRecord()
+			defer record.Release()
+
+			// Now, write the record to an IPC file and read it back again
+			read := roundTrip(record)
+			Expect(read.NumRows()).Should(Equal(int64(2)))
+
+			// Finally, verify that each of the values was read back correctly
+			priceReader, err := NewDecimalReader(read.Column(0))
+			Expect(err).ShouldNot(HaveOccurred())
+			timestampReader, err := NewTimestampReader(read.Column(1))
+			Expect(err).ShouldNot(HaveOccurred())
+			latencyReader, err := NewDurationReader(read.Column(2))
+			Expect(err).ShouldNot(HaveOccurred())
+			classReader, err := NewEnumReader[gopb.Financial_Common_AssetClass](read.Column(3))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(priceReader.Len()).Should(Equal(2))
+			Expect(priceReader.Value(0).ToDecimal().String()).Should(Equal(price))
+			Expect(priceReader.Value(1)).Should(BeNil())
+			Expect(timestampReader.Len()).Should(Equal(2))
+			Expect(timestampReader.Value(0)).Should(Equal(gopb.NewUnixTimestamp(1654127993, 983000001)))
+			Expect(timestampReader.Value(1)).Should(BeNil())
+			Expect(latencyReader.Len()).Should(Equal(2))
+			Expect(latencyReader.Value(0)).Should(Equal(gopb.NewUnixDuration(-90, -500000000)))
+			Expect(latencyReader.Value(1)).Should(BeNil())
+			Expect(classReader.Len()).Should(Equal(2))
+			Expect(classReader.Value(0)).Should(Equal(gopb.Financial_Common_Crypto))
+			Expect(classReader.Value(1)).Should(Equal(utils.NoValue[gopb.Financial_Common_AssetClass]()))
+		},
+		Entry("Decimal128 - Works", int32(18), "-123.456"),
+		Entry("Decimal256 - Works", int32(60), "123456789012345678901234567890123456789012.345"))
+
+	// Tests that the enum dictionary is written using the JSON names of the enum values
+	It("EnumBuilder - Writes JSON names", func() {
+		builder := array.NewRecordBuilder(memory.DefaultAllocator, newSchema(10, 2))
+		defer builder.Release()
+
+		classes, err := NewEnumBuilder[gopb.Financial_Common_AssetClass](builder.Field(3))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(classes.Append(gopb.Financial_Common_Crypto)).ShouldNot(HaveOccurred())
+		Expect(classes.Append(gopb.Financial_Common_Crypto)).ShouldNot(HaveOccurred())
+
+		column := builder.Field(3).NewArray()
+		defer column.Release()
+
+		dictionary := column.(*array.Dictionary).Dictionary().(*array.String)
+		Expect(dictionary.Len()).Should(Equal(1))
+		Expect(dictionary.Value(0)).Should(Equal(utils.Describe(gopb.Financial_Common_Crypto).OutputName))
+	})
+
+	// Tests that the DecimalBuilder returns an error if the value cannot be stored at its scale
+	It("DecimalBuilder - Too many decimal places - Error", func() {
+		builder := array.NewDecimal128Builder(memory.DefaultAllocator, &arrow.Decimal128Type{Precision: 10, Scale: 2})
+		defer builder.Release()
+
+		wrapped, err := NewDecimalBuilder(builder)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = wrapped.Append(gopb.NewFromDecimal(mustParse("1.234")))
+		Expect(err).Should(HaveOccurred())
+		Expect(errors.Is(err, utils.ErrPrecisionLoss)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("Decimal (1.234) has more than 2 decimal places: precision loss"))
+		Expect(builder.Len()).Should(BeZero())
+	})
+
+	// Tests that the DecimalBuilder returns an error if the value has more digits than the precision allows
+	DescribeTable("DecimalBuilder - Exceeds precision - Error",
+		func(value string, bound string, inner error) {
+			builder := array.NewDecimal128Builder(memory.DefaultAllocator, &arrow.Decimal128Type{Precision: 5, Scale: 2})
+			defer builder.Release()
+
+			wrapped, err := NewDecimalBuilder(builder)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wrapped.Append(gopb.NewFromDecimal(mustParse(value)))
+			var rErr *utils.RangeError
+			Expect(errors.As(err, &rErr)).Should(BeTrue())
+			Expect(rErr.Value).Should(Equal(value))
+			Expect(rErr.Bound).Should(Equal(bound))
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+			Expect(builder.Len()).Should(BeZero())
+		},
+		Entry("Too large - Overflow", "1000", "999.99", utils.ErrOverflow),
+		Entry("Too small - Underflow", "-1000", "-999.99", utils.ErrUnderflow))
+
+	// Tests that the TimestampBuilder returns an error if the timestamp is more precise than its unit
+	It("TimestampBuilder - Too precise - Error", func() {
+		builder := array.NewTimestampBuilder(memory.DefaultAllocator, &arrow.TimestampType{Unit: arrow.Millisecond})
+		defer builder.Release()
+
+		wrapped, err := NewTimestampBuilder(builder)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = wrapped.Append(gopb.NewUnixTimestamp(1654127993, 983000001))
+		Expect(err).Should(HaveOccurred())
+		Expect(errors.Is(err, utils.ErrPrecisionLoss)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("timestamp (1654127993, 983000001) cannot be written in units of ms: precision loss"))
+		Expect(builder.Len()).Should(BeZero())
+	})
+
+	// Tests that the TimestampBuilder can write timestamps in coarser units, and that they are read back
+	It("TimestampBuilder - Milliseconds - Works", func() {
+		builder := array.NewTimestampBuilder(memory.DefaultAllocator, &arrow.TimestampType{Unit: arrow.Millisecond})
+		defer builder.Release()
+
+		wrapped, err := NewTimestampBuilder(builder)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(wrapped.Append(gopb.NewUnixTimestamp(1654127993, 983000000))).ShouldNot(HaveOccurred())
+		wrapped.AppendNull()
+
+		column := builder.NewArray()
+		defer column.Release()
+
+		reader, err := NewTimestampReader(column)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(reader.Value(0)).Should(Equal(gopb.NewUnixTimestamp(1654127993, 983000000)))
+		Expect(reader.Value(1)).Should(BeNil())
+	})
+
+	// Tests that the TimestampBuilder returns an error if the timestamp cannot be represented in nanoseconds
+	DescribeTable("TimestampBuilder - Out of range - Error",
+		func(seconds int64, bound string, inner error) {
+			builder := array.NewTimestampBuilder(memory.DefaultAllocator, TimestampType.(*arrow.TimestampType))
+			defer builder.Release()
+
+			wrapped, err := NewTimestampBuilder(builder)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = wrapped.Append(gopb.NewUnixTimestamp(seconds, 0))
+			var rErr *utils.RangeError
+			Expect(errors.As(err, &rErr)).Should(BeTrue())
+			Expect(rErr.Bound).Should(Equal(bound))
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+			Expect(builder.Len()).Should(BeZero())
+		},
+		Entry("After 2262 - Overflow", int64(9300000000), "2262-04-11T23:47:16.854775807Z", utils.ErrOverflow),
+		Entry("Before 1677 - Underflow", int64(-9300000000), "1677-09-21T00:12:43.145224192Z", utils.ErrUnderflow))
+
+	// Tests that the DurationBuilder can write durations in coarser units, and that they are read back
+	It("DurationBuilder - Seconds - Works", func() {
+		builder := array.NewDurationBuilder(memory.DefaultAllocator, &arrow.DurationType{Unit: arrow.Second})
+		defer builder.Release()
+
+		wrapped, err := NewDurationBuilder(builder)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(wrapped.Append(gopb.NewFromDuration(-90 * time.Second))).ShouldNot(HaveOccurred())
+
+		err = wrapped.Append(gopb.NewUnixDuration(1, 500000000))
+		Expect(errors.Is(err, utils.ErrPrecisionLoss)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("duration (1, 500000000) cannot be written in units of s: precision loss"))
+
+		column := builder.NewArray()
+		defer column.Release()
+
+		reader, err := NewDurationReader(column)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(reader.Len()).Should(Equal(1))
+		Expect(reader.Value(0)).Should(Equal(gopb.NewUnixDuration(-90, 0)))
+	})
+
+	// Tests that each of the builders returns an error if it is created from a builder of the wrong type
+	It("New*Builder - Wrong type - Error", func() {
+		builder := array.NewStringBuilder(memory.DefaultAllocator)
+		defer builder.Release()
+
+		var tErr *utils.TypeError
+		_, err := NewDecimalBuilder(builder)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+		Expect(tErr.Type).Should(Equal("Decimal"))
+		Expect(err.Error()).Should(Equal("Arrow builder of utf8 could not be converted to a Decimal"))
+
+		_, err = NewTimestampBuilder(builder)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+		Expect(tErr.Type).Should(Equal("UnixTimestamp"))
+
+		_, err = NewDurationBuilder(builder)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+		Expect(tErr.Type).Should(Equal("UnixDuration"))
+
+		_, err = NewEnumBuilder[gopb.Financial_Common_AssetClass](builder)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+		Expect(tErr.Type).Should(Equal("gopb.Financial_Common_AssetClass"))
+	})
+
+	// Tests that each of the readers returns an error if it is created from an array of the wrong type
+	It("New*Reader - Wrong type - Error", func() {
+		builder := array.NewStringBuilder(memory.DefaultAllocator)
+		defer builder.Release()
+		builder.Append("AAPL")
+
+		column := builder.NewArray()
+		defer column.Release()
+
+		var tErr *utils.TypeError
+		_, err := NewDecimalReader(column)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("Arrow array of utf8 could not be converted to a Decimal"))
+
+		_, err = NewTimestampReader(column)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+
+		_, err = NewDurationReader(column)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+
+		_, err = NewEnumReader[gopb.Financial_Common_AssetClass](column)
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+	})
+
+	// Tests that the EnumReader returns an error if the dictionary contains a name that is not a value of
+	// the enum
+	It("NewEnumReader - Unknown name - Error", func() {
+		builder := array.NewBuilder(memory.DefaultAllocator, EnumType).(*array.BinaryDictionaryBuilder)
+		defer builder.Release()
+		Expect(builder.AppendString("Derp")).ShouldNot(HaveOccurred())
+
+		column := builder.NewArray()
+		defer column.Release()
+
+		reader, err := NewEnumReader[gopb.Financial_Common_AssetClass](column)
+		Expect(reader).Should(BeNil())
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+// Helper function that parses a decimal string, failing the test if it is invalid
+func mustParse(raw string) decimal.Decimal {
+	value, err := decimal.NewFromString(raw)
+	Expect(err).ShouldNot(HaveOccurred())
+	return value
+}
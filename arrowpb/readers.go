@@ -0,0 +1,174 @@
+package arrowpb
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/apache/arrow/go/v11/arrow/array"
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/gopb"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// DecimalReader reads Decimal values from an Arrow decimal128 or decimal256 array. The reader does not
+// retain the array, so it must not be released while the reader is in use
+type DecimalReader struct {
+	array arrow.Array
+	scale int32
+}
+
+// NewDecimalReader creates a new DecimalReader from the Arrow array provided. An error will be returned if
+// the array is not a decimal array
+func NewDecimalReader(arr arrow.Array) (*DecimalReader, error) {
+	switch dtype := arr.DataType().(type) {
+	case *arrow.Decimal128Type:
+		return &DecimalReader{array: arr, scale: dtype.Scale}, nil
+	case *arrow.Decimal256Type:
+		return &DecimalReader{array: arr, scale: dtype.Scale}, nil
+	default:
+		return nil, typeError("Arrow array", arr.DataType(), "Decimal")
+	}
+}
+
+// Len returns the number of values in the array
+func (r *DecimalReader) Len() int {
+	return r.array.Len()
+}
+
+// Value returns the Decimal at the index provided, or nil if the value is null
+func (r *DecimalReader) Value(i int) *gopb.Decimal {
+	if r.array.IsNull(i) {
+		return nil
+	}
+
+	var coefficient *big.Int
+	switch casted := r.array.(type) {
+	case *array.Decimal128:
+		coefficient = casted.Value(i).BigInt()
+	case *array.Decimal256:
+		coefficient = casted.Value(i).BigInt()
+	}
+
+	return gopb.NewFromDecimal(decimal.NewFromBigInt(coefficient, -r.scale))
+}
+
+// TimestampReader reads UnixTimestamp values from an Arrow timestamp array. The reader does not retain the
+// array, so it must not be released while the reader is in use
+type TimestampReader struct {
+	array *array.Timestamp
+	unit  arrow.TimeUnit
+}
+
+// NewTimestampReader creates a new TimestampReader from the Arrow array provided. An error will be
+// returned if the array is not a timestamp array
+func NewTimestampReader(arr arrow.Array) (*TimestampReader, error) {
+	casted, ok := arr.(*array.Timestamp)
+	if !ok {
+		return nil, typeError("Arrow array", arr.DataType(), "UnixTimestamp")
+	}
+
+	return &TimestampReader{array: casted, unit: casted.DataType().(*arrow.TimestampType).Unit}, nil
+}
+
+// Len returns the number of values in the array
+func (r *TimestampReader) Len() int {
+	return r.array.Len()
+}
+
+// Value returns the UnixTimestamp at the index provided, or nil if the value is null
+func (r *TimestampReader) Value(i int) *gopb.UnixTimestamp {
+	if r.array.IsNull(i) {
+		return nil
+	}
+
+	return gopb.NewFromTime(r.array.Value(i).ToTime(r.unit))
+}
+
+// DurationReader reads UnixDuration values from an Arrow duration array. The reader does not retain the
+// array, so it must not be released while the reader is in use
+type DurationReader struct {
+	array *array.Duration
+	unit  arrow.TimeUnit
+}
+
+// NewDurationReader creates a new DurationReader from the Arrow array provided. An error will be returned
+// if the array is not a duration array
+func NewDurationReader(arr arrow.Array) (*DurationReader, error) {
+	casted, ok := arr.(*array.Duration)
+	if !ok {
+		return nil, typeError("Arrow array", arr.DataType(), "UnixDuration")
+	}
+
+	return &DurationReader{array: casted, unit: casted.DataType().(*arrow.DurationType).Unit}, nil
+}
+
+// Len returns the number of values in the array
+func (r *DurationReader) Len() int {
+	return r.array.Len()
+}
+
+// Value returns the UnixDuration at the index provided, or nil if the value is null
+func (r *DurationReader) Value(i int) *gopb.UnixDuration {
+	if r.array.IsNull(i) {
+		return nil
+	}
+
+	// Split the value into whole seconds and the remaining units, so that durations in coarse units
+	// which are too long to be represented as a time.Duration can still be converted
+	perSecond := int64(time.Second / r.unit.Multiplier())
+	value := int64(r.array.Value(i))
+	return gopb.NewUnixDuration(value/perSecond, int32(value%perSecond*int64(r.unit.Multiplier())))
+}
+
+// EnumReader reads enum values from an Arrow dictionary array with string values. Each name in the
+// dictionary is converted to an enum value when the reader is created. The reader does not retain the
+// array, so it must not be released while the reader is in use
+type EnumReader[T utils.ProtoEnum] struct {
+	array  *array.Dictionary
+	values []T
+}
+
+// NewEnumReader creates a new EnumReader from the Arrow array provided. An error will be returned if the
+// array is not a dictionary array with string values, or if any of the names in its dictionary cannot be
+// mapped to a value of the enum
+func NewEnumReader[T utils.ProtoEnum](arr arrow.Array) (*EnumReader[T], error) {
+	casted, ok := arr.(*array.Dictionary)
+	var names *array.String
+	if ok {
+		names, ok = casted.Dictionary().(*array.String)
+	}
+
+	if !ok {
+		var value T
+		return nil, typeError("Arrow array", arr.DataType(), fmt.Sprintf("%T", value))
+	}
+
+	values := make([]T, names.Len())
+	for i := range values {
+		value, err := utils.Parse[T](names.Value(i))
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+	}
+
+	return &EnumReader[T]{array: casted, values: values}, nil
+}
+
+// Len returns the number of values in the array
+func (r *EnumReader[T]) Len() int {
+	return r.array.Len()
+}
+
+// Value returns the enum value at the index provided. If the value is null then the equivalent of
+// utils.NoValue will be returned
+func (r *EnumReader[T]) Value(i int) T {
+	if r.array.IsNull(i) {
+		return utils.NoValue[T]()
+	}
+
+	return r.values[r.array.GetValueIndex(i)]
+}
@@ -0,0 +1,58 @@
+// Package arrowpb maps the types in the gopb package to Apache Arrow types, and provides typed builders and
+// readers that convert between gopb values and Arrow arrays. This allows market data to be written to Arrow
+// IPC files, and consumed by columnar tools such as DuckDB or Polars, without any per-field conversion code.
+package arrowpb
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// The maximum precision of an Arrow decimal128, in digits. Decimals with a greater precision will be
+// mapped to a decimal256 instead
+const MaxDecimal128Precision = 38
+
+// The maximum precision of an Arrow decimal256, in digits
+const MaxDecimal256Precision = 76
+
+// TimestampType is the Arrow type that a UnixTimestamp is mapped to
+var TimestampType arrow.DataType = &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+
+// DurationType is the Arrow type that a UnixDuration is mapped to
+var DurationType arrow.DataType = &arrow.DurationType{Unit: arrow.Nanosecond}
+
+// EnumType is the Arrow type that an enum is mapped to. Each value is written as its name, and the names
+// are dictionary-encoded so that each is only stored once
+var EnumType arrow.DataType = &arrow.DictionaryType{
+	IndexType: arrow.PrimitiveTypes.Int32,
+	ValueType: arrow.BinaryTypes.String,
+}
+
+// DecimalType returns the Arrow type that a Decimal with the precision and scale provided is mapped to.
+// This will be a decimal128 if the precision is no more than 38 digits, or a decimal256 otherwise. An
+// error will be returned if the precision is not between 1 and 76 digits
+func DecimalType(precision int32, scale int32) (arrow.DataType, error) {
+	switch {
+	case precision < 1:
+		return nil, precisionError(precision, "1", utils.ErrUnderflow)
+	case precision <= MaxDecimal128Precision:
+		return &arrow.Decimal128Type{Precision: precision, Scale: scale}, nil
+	case precision <= MaxDecimal256Precision:
+		return &arrow.Decimal256Type{Precision: precision, Scale: scale}, nil
+	default:
+		return nil, precisionError(precision, fmt.Sprintf("%d", MaxDecimal256Precision), utils.ErrOverflow)
+	}
+}
+
+// Helper function that creates an error for a decimal precision that Arrow does not support
+func precisionError(precision int32, bound string, err error) error {
+	return &utils.RangeError{Type: "decimal precision", Value: fmt.Sprintf("%d", precision), Bound: bound, Err: err}
+}
+
+// Helper function that creates an error for an Arrow builder or array that has a different type from the
+// one expected for a gopb type
+func typeError(source string, actual arrow.DataType, typ string) error {
+	return &utils.TypeError{Source: source, Actual: actual.String(), Type: typ}
+}
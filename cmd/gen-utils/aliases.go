@@ -0,0 +1,299 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xefino/protobuf-gen-go/utils"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gopkg.in/yaml.v3"
+)
+
+// AliasFile describes the YAML file containing the aliases for the enums in a proto package
+type AliasFile struct {
+	Package string         `yaml:"package"`
+	Enums   []*EnumAliases `yaml:"enums"`
+}
+
+// EnumAliases describes the aliases and formats associated with a single enum
+type EnumAliases struct {
+	Enum           string       `yaml:"enum"`
+	Prefix         string       `yaml:"prefix"`
+	CSV            string       `yaml:"csv"`
+	SQL            string       `yaml:"sql"`
+	RequireMapping bool         `yaml:"require_mapping"`
+	Alternates     AliasEntries `yaml:"alternates"`
+	Mapping        AliasEntries `yaml:"mapping"`
+	Collisions     []string     `yaml:"collisions"`
+	Codes          CodeGroups   `yaml:"codes"`
+}
+
+// AliasEntry is a single key-value pair from an alternates or mapping table
+type AliasEntry struct {
+	Key   string
+	Value string
+}
+
+// AliasEntries is a list of key-value pairs, read from a YAML mapping, that preserves the order in which the
+// pairs appear in the file so that the generated tables appear in the same order
+type AliasEntries []AliasEntry
+
+// UnmarshalYAML converts a YAML mapping node into a list of entries
+func (entries *AliasEntries) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: YAML node had an invalid kind (expected mapping)", value.Line)
+	}
+
+	*entries = make(AliasEntries, 0, len(value.Content)/2)
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		key, val := value.Content[i], value.Content[i+1]
+		if key.Kind != yaml.ScalarNode || val.Kind != yaml.ScalarNode {
+			return fmt.Errorf("line %d: YAML node had an invalid kind (expected scalar value)", key.Line)
+		}
+
+		*entries = append(*entries, AliasEntry{Key: key.Value, Value: val.Value})
+	}
+
+	return nil
+}
+
+// CodeGroup is a table of the codes used for the values of an enum by a single source, such as a SIP feed
+type CodeGroup struct {
+	Name  string
+	Codes AliasEntries
+}
+
+// CodeGroups is a list of code tables, read from a YAML mapping of source names to codes, that preserves the
+// order in which the tables appear in the file. Tables may be YAML aliases of other tables
+type CodeGroups []CodeGroup
+
+// UnmarshalYAML converts a YAML mapping node into a list of code tables
+func (groups *CodeGroups) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: YAML node had an invalid kind (expected mapping)", value.Line)
+	}
+
+	*groups = make(CodeGroups, 0, len(value.Content)/2)
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		key, val := value.Content[i], value.Content[i+1]
+		if key.Kind != yaml.ScalarNode {
+			return fmt.Errorf("line %d: YAML node had an invalid kind (expected scalar value)", key.Line)
+		} else if val.Kind == yaml.AliasNode {
+			val = val.Alias
+		}
+
+		group := CodeGroup{Name: key.Value}
+		if err := group.Codes.UnmarshalYAML(val); err != nil {
+			return err
+		}
+
+		*groups = append(*groups, group)
+	}
+
+	return nil
+}
+
+// ParseAliasFile parses the contents of an alias file
+func ParseAliasFile(raw []byte) (*AliasFile, error) {
+	var file AliasFile
+	if err := yaml.Unmarshal(raw, &file); err != nil {
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// Enum contains all the information necessary to generate the code for a single enum
+type Enum struct {
+	*EnumAliases
+	Descriptor   protoreflect.EnumDescriptor
+	GoType       string
+	Alternates   []AliasEntry
+	Mapping      []AliasEntry
+	Descriptions []AliasEntry
+	Codes        []CodeGroup
+	Collisions   []string
+}
+
+// Resolve matches each enum in the alias file to its descriptor in the registry and converts the names in
+// its alternates and mapping tables to the Go identifiers they refer to. An error will be returned if an
+// enum in the proto package is missing from the alias file, if an alias refers to a value that does not
+// exist or if a value is missing a mapping that the alias file requires
+func Resolve(file *AliasFile, registry *protoregistry.Files) ([]*Enum, error) {
+
+	// First, collect all the enums declared in the proto package so we can verify that none are missing
+	declared := make(map[protoreflect.FullName]protoreflect.EnumDescriptor)
+	registry.RangeFilesByPackage(protoreflect.FullName(file.Package), func(fd protoreflect.FileDescriptor) bool {
+		collectEnums(fd.Enums(), fd.Messages(), declared)
+		return true
+	})
+
+	// Next, iterate over the enums in the alias file and resolve each against its descriptor
+	enums := make([]*Enum, 0, len(file.Enums))
+	for _, aliases := range file.Enums {
+		name := protoreflect.FullName(file.Package + "." + aliases.Enum)
+		desc, ok := declared[name]
+		if !ok {
+			return nil, fmt.Errorf("enum %s was not found in proto package %s", aliases.Enum, file.Package)
+		} else if aliases.Prefix == "" {
+			return nil, fmt.Errorf("enum %s does not have a prefix", aliases.Enum)
+		}
+
+		delete(declared, name)
+		enum, err := resolveEnum(aliases, desc)
+		if err != nil {
+			return nil, err
+		}
+
+		enums = append(enums, enum)
+	}
+
+	// Finally, verify that every enum was included in the alias file
+	if len(declared) > 0 {
+		missing := make([]string, 0, len(declared))
+		for name := range declared {
+			missing = append(missing, string(name))
+		}
+
+		sort.Strings(missing)
+		return nil, fmt.Errorf("enums %s are not listed in the alias file", strings.Join(missing, ", "))
+	}
+
+	return enums, nil
+}
+
+// Helper function that resolves the aliases for a single enum against its descriptor
+func resolveEnum(aliases *EnumAliases, desc protoreflect.EnumDescriptor) (*Enum, error) {
+	enum := Enum{
+		EnumAliases: aliases,
+		Descriptor:  desc,
+		GoType:      goName(desc),
+		Alternates:  make([]AliasEntry, len(aliases.Alternates)),
+		Mapping:     make([]AliasEntry, len(aliases.Mapping)),
+		Codes:       make([]CodeGroup, len(aliases.Codes)),
+		Collisions:  aliases.Collisions,
+	}
+
+	// Convert each of the alternates into its Go value; these can be value names or integers
+	alternates := make(map[string]bool, len(aliases.Alternates))
+	for i, entry := range aliases.Alternates {
+		value, err := enum.valueIdent(entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("alternate %q for enum %s is invalid: %v", entry.Key, aliases.Enum, err)
+		}
+
+		alternates[entry.Key] = true
+		enum.Alternates[i] = AliasEntry{Key: strconv.Quote(entry.Key), Value: value}
+	}
+
+	// Convert each of the codes into its Go value and add it to the alternates, prefixed by the name of its
+	// source, unless an alternate with the same name already exists. Codes are case-sensitive, so codes that
+	// only differ by case are allowed to collide after normalization
+	allowed := make(map[string]bool, len(aliases.Collisions))
+	for _, form := range aliases.Collisions {
+		allowed[form] = true
+	}
+
+	normalized := make(map[string]string)
+	for i, group := range aliases.Codes {
+		enum.Codes[i] = CodeGroup{Name: strconv.Quote(group.Name), Codes: make(AliasEntries, len(group.Codes))}
+		for j, entry := range group.Codes {
+			value, err := enum.valueIdent(entry.Value)
+			if err != nil {
+				return nil, fmt.Errorf("code %q from %s for enum %s is invalid: %v",
+					entry.Key, group.Name, aliases.Enum, err)
+			}
+
+			enum.Codes[i].Codes[j] = AliasEntry{Key: strconv.Quote(entry.Key), Value: value}
+			key := group.Name + ":" + entry.Key
+			if alternates[key] {
+				continue
+			}
+
+			alternates[key] = true
+			enum.Alternates = append(enum.Alternates, AliasEntry{Key: strconv.Quote(key), Value: value})
+			form := utils.DefaultNormalizer(key)
+			if existing, ok := normalized[form]; ok && existing != value && !allowed[form] {
+				allowed[form] = true
+				enum.Collisions = append(enum.Collisions, form)
+			}
+
+			normalized[form] = value
+		}
+	}
+
+	// Convert each of the mappings into its Go value; these must be value names
+	mapped := make(map[string]bool, len(aliases.Mapping))
+	for i, entry := range aliases.Mapping {
+		value := desc.Values().ByName(protoreflect.Name(entry.Key))
+		if value == nil {
+			return nil, fmt.Errorf("mapping for enum %s refers to value %s, which does not exist",
+				aliases.Enum, entry.Key)
+		}
+
+		mapped[entry.Key] = true
+		enum.Mapping[i] = AliasEntry{Key: goValueName(value), Value: strconv.Quote(entry.Value)}
+	}
+
+	// If the alias file requires a mapping for every value then check that none are missing
+	if aliases.RequireMapping {
+		for i := 0; i < desc.Values().Len(); i++ {
+			if name := string(desc.Values().Get(i).Name()); !mapped[name] {
+				return nil, fmt.Errorf("value %s of enum %s has no mapping but the alias file requires one",
+					name, aliases.Enum)
+			}
+		}
+	}
+
+	return &enum, nil
+}
+
+// Helper function that converts the name or integer value of an enum value to its Go representation
+func (enum *Enum) valueIdent(raw string) (string, error) {
+	if value := enum.Descriptor.Values().ByName(protoreflect.Name(raw)); value != nil {
+		return goValueName(value), nil
+	}
+
+	number, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return "", fmt.Errorf("value %s does not exist", raw)
+	} else if number == -1 {
+		return fmt.Sprintf("utils.NoValue[%s]()", enum.GoType), nil
+	}
+
+	return fmt.Sprintf("%s(%d)", enum.GoType, number), nil
+}
+
+// Helper function that collects the enums declared in a list of enums and messages, including any declared
+// in nested messages
+func collectEnums(enums protoreflect.EnumDescriptors, messages protoreflect.MessageDescriptors,
+	collected map[protoreflect.FullName]protoreflect.EnumDescriptor) {
+	for i := 0; i < enums.Len(); i++ {
+		collected[enums.Get(i).FullName()] = enums.Get(i)
+	}
+
+	for i := 0; i < messages.Len(); i++ {
+		collectEnums(messages.Get(i).Enums(), messages.Get(i).Messages(), collected)
+	}
+}
+
+// Helper function that determines the Go name protoc-gen-go gives to an enum or message, which is its
+// full name within its package, with the dots replaced by underscores
+func goName(desc protoreflect.Descriptor) string {
+	name := strings.TrimPrefix(string(desc.FullName()), string(desc.ParentFile().Package())+".")
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// Helper function that determines the Go name protoc-gen-go gives to an enum value. Values of enums that
+// are nested inside a message are prefixed with the message's name, and other values with the enum's name
+func goValueName(value protoreflect.EnumValueDescriptor) string {
+	enum := value.Parent()
+	if parent, ok := enum.Parent().(protoreflect.MessageDescriptor); ok {
+		return goName(parent) + "_" + string(value.Name())
+	}
+
+	return goName(enum) + "_" + string(value.Name())
+}
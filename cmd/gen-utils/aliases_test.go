@@ -0,0 +1,197 @@
+package main
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var _ = Describe("Alias File Tests", func() {
+
+	// Helper function that creates an alias file containing every enum in the gopb package, with the
+	// entry for the Provider enum replaced by the one provided
+	aliasFile := func(provider *EnumAliases) *AliasFile {
+		raw, err := os.ReadFile("../../gopb/aliases.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		file, err := ParseAliasFile(raw)
+		Expect(err).ShouldNot(HaveOccurred())
+		for i, enum := range file.Enums {
+			if enum.Enum == "Provider" {
+				file.Enums[i] = provider
+			}
+		}
+
+		return file
+	}
+
+	// Tests that the code generated from the alias file matches the code in the gopb package, so that
+	// changes to the alias file or the generator are not forgotten
+	It("Generate - Generated code is up to date", func() {
+		raw, err := os.ReadFile("../../gopb/aliases.yaml")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		file, err := ParseAliasFile(raw)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		enums, err := Resolve(file, protoregistry.GlobalFiles)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		comments, err := ParseComments("../../gopb")
+		Expect(err).ShouldNot(HaveOccurred())
+		AddDescriptions(enums, comments)
+
+		code, err := Generate("aliases.yaml", enums)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		existing, err := os.ReadFile("../../gopb/utils_gen.go")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(code)).Should(Equal(string(existing)),
+			"utils_gen.go is out of date; run go generate in the gopb directory")
+	})
+
+	// Tests that the entries in an alias file are parsed in the order they appear
+	It("ParseAliasFile - AliasEntries are ordered - Works", func() {
+		file, err := ParseAliasFile([]byte("package: protos.common\nenums:\n" +
+			"  - enum: Provider\n    prefix: Provider\n    alternates:\n" +
+			"      \"z\": Polygon\n      \"a\": None\n      \"m\": -1\n"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(file.Enums).Should(HaveLen(1))
+		Expect(file.Enums[0].Alternates).Should(Equal(AliasEntries{
+			{Key: "z", Value: "Polygon"}, {Key: "a", Value: "None"}, {Key: "m", Value: "-1"}}))
+	})
+
+	// Tests that an alias file with an invalid alternates table cannot be parsed
+	It("ParseAliasFile - Alternates not a mapping - Fails", func() {
+		_, err := ParseAliasFile([]byte("enums:\n  - enum: Provider\n    alternates:\n      - None\n"))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("line 4: YAML node had an invalid kind (expected mapping)"))
+	})
+
+	// Tests that code tables are parsed in the order they appear, and that they can be YAML aliases
+	It("ParseAliasFile - CodeGroups with aliases - Works", func() {
+		file, err := ParseAliasFile([]byte("package: protos.common\nenums:\n" +
+			"  - enum: Provider\n    prefix: Provider\n    codes:\n" +
+			"      \"B\": &shared\n        \"1\": Polygon\n        \"0\": None\n      \"A\": *shared\n"))
+		Expect(err).ShouldNot(HaveOccurred())
+		entries := AliasEntries{{Key: "1", Value: "Polygon"}, {Key: "0", Value: "None"}}
+		Expect(file.Enums[0].Codes).Should(Equal(CodeGroups{{Name: "B", Codes: entries}, {Name: "A", Codes: entries}}))
+	})
+
+	// Tests that codes are converted to their Go identifiers and added to the alternates, prefixed by their
+	// source, and that codes which only differ by case are allowed to collide
+	It("Resolve - Codes - Works", func() {
+		enums, err := Resolve(aliasFile(&EnumAliases{
+			Enum:       "Provider",
+			Prefix:     "Provider",
+			Alternates: AliasEntries{{Key: "X:p", Value: "None"}},
+			Codes: CodeGroups{{Name: "X", Codes: AliasEntries{
+				{Key: "p", Value: "Polygon"}, {Key: "n", Value: "None"}, {Key: "N", Value: "Polygon"}}}},
+		}), protoregistry.GlobalFiles)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(enums[0].Codes).Should(Equal([]CodeGroup{{Name: "\"X\"", Codes: AliasEntries{
+			{Key: "\"p\"", Value: "Provider_Polygon"},
+			{Key: "\"n\"", Value: "Provider_None"},
+			{Key: "\"N\"", Value: "Provider_Polygon"}}}}))
+		Expect(enums[0].Alternates).Should(Equal([]AliasEntry{
+			{Key: "\"X:p\"", Value: "Provider_None"},
+			{Key: "\"X:n\"", Value: "Provider_None"},
+			{Key: "\"X:N\"", Value: "Provider_Polygon"}}))
+		Expect(enums[0].Collisions).Should(Equal([]string{"xn"}))
+
+		code, err := Generate("aliases.yaml", enums)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(code)).Should(ContainSubstring("var providerCodes = map[string]map[string]Provider{\n" +
+			"\t\"X\": {\n\t\t\"p\": Provider_Polygon,\n"))
+	})
+
+	// Tests that alternates and mappings are converted to their Go identifiers
+	It("Resolve - Works", func() {
+		enums, err := Resolve(aliasFile(&EnumAliases{
+			Enum:       "Provider",
+			Prefix:     "Provider",
+			Alternates: AliasEntries{{Key: "poly", Value: "Polygon"}, {Key: "", Value: "-1"}, {Key: "x", Value: "7"}},
+			Mapping:    AliasEntries{{Key: "Polygon", Value: "Polygon.io"}},
+		}), protoregistry.GlobalFiles)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(enums[0].GoType).Should(Equal("Provider"))
+		Expect(enums[0].Alternates).Should(Equal([]AliasEntry{
+			{Key: "\"poly\"", Value: "Provider_Polygon"},
+			{Key: "\"\"", Value: "utils.NoValue[Provider]()"},
+			{Key: "\"x\"", Value: "Provider(7)"}}))
+		Expect(enums[0].Mapping).Should(Equal([]AliasEntry{{Key: "Provider_Polygon", Value: "\"Polygon.io\""}}))
+
+		// Nested enums should be named after their parent messages
+		for _, enum := range enums {
+			if enum.Enum == "Financial.Common.AssetClass" {
+				Expect(enum.GoType).Should(Equal("Financial_Common_AssetClass"))
+				Expect(enum.Alternates[1].Value).Should(Equal("Financial_Common_Stock"))
+			}
+		}
+	})
+
+	// Tests that Resolve fails if the alias file requires a mapping for every value but one is missing
+	It("Resolve - Required mapping missing - Fails", func() {
+		_, err := Resolve(aliasFile(&EnumAliases{
+			Enum:           "Provider",
+			Prefix:         "Provider",
+			RequireMapping: true,
+			Mapping:        AliasEntries{{Key: "None", Value: ""}},
+		}), protoregistry.GlobalFiles)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("value Polygon of enum Provider has no mapping but the alias file requires one"))
+	})
+
+	// Tests that Resolve fails if an alias refers to a value that does not exist
+	It("Resolve - Unknown value - Fails", func() {
+		_, err := Resolve(aliasFile(&EnumAliases{
+			Enum:       "Provider",
+			Prefix:     "Provider",
+			Alternates: AliasEntries{{Key: "poly", Value: "Polygons"}},
+		}), protoregistry.GlobalFiles)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("alternate \"poly\" for enum Provider is invalid: value Polygons does not exist"))
+
+		_, err = Resolve(aliasFile(&EnumAliases{
+			Enum:    "Provider",
+			Prefix:  "Provider",
+			Mapping: AliasEntries{{Key: "Polygons", Value: "polygon"}},
+		}), protoregistry.GlobalFiles)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("mapping for enum Provider refers to value Polygons, which does not exist"))
+	})
+
+	// Tests that Resolve fails if an enum in the proto package is not listed in the alias file
+	It("Resolve - Enum missing - Fails", func() {
+		file := aliasFile(&EnumAliases{Enum: "Provider", Prefix: "Provider"})
+		file.Enums = file.Enums[1:]
+		_, err := Resolve(file, protoregistry.GlobalFiles)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("enums protos.common.Provider are not listed in the alias file"))
+	})
+
+	// Tests that Generate fails if an enum has an invalid CSV format
+	It("Generate - Invalid CSV format - Fails", func() {
+		enums, err := Resolve(aliasFile(&EnumAliases{Enum: "Provider", Prefix: "Provider", CSV: "hex"}),
+			protoregistry.GlobalFiles)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = Generate("aliases.yaml", enums)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("enum Provider has an invalid CSV format of \"hex\""))
+	})
+
+	// Tests that the normal forms an enum is allowed to collide on are passed to its codec
+	It("Generate - Allowed collisions - Works", func() {
+		enums, err := Resolve(aliasFile(&EnumAliases{Enum: "Provider", Prefix: "Provider",
+			Collisions: []string{"none", "poly"}}), protoregistry.GlobalFiles)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		code, err := Generate("aliases.yaml", enums)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(code)).Should(ContainSubstring("var providerCodec = utils.NewEnumCodec[Provider](nil, nil).\n" +
+			"\tWithAllowedCollisions(\"none\", \"poly\")\n"))
+	})
+})
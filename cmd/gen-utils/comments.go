@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ParseComments reads the .pb.go files in a directory and collects the line comment attached to each
+// constant, keyed by the name of the constant. protoc-gen-go copies the comment on each enum value in
+// the proto source to the constant it generates for it, so these can be used to describe the values
+func ParseComments(dir string) (map[string]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pb.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	comments := make(map[string]string)
+	for _, path := range paths {
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s, error: %v", path, err)
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				value, ok := spec.(*ast.ValueSpec)
+				if !ok || value.Comment == nil {
+					continue
+				}
+
+				for _, name := range value.Names {
+					comments[name.Name] = strings.TrimSpace(value.Comment.Text())
+				}
+			}
+		}
+	}
+
+	return comments, nil
+}
+
+// AddDescriptions sets the descriptions of the values of each enum from the comments provided, which
+// are keyed by the Go names of the values. Values without a comment will not have a description
+func AddDescriptions(enums []*Enum, comments map[string]string) {
+	for _, enum := range enums {
+		enum.Descriptions = nil
+		values := enum.Descriptor.Values()
+		for i := 0; i < values.Len(); i++ {
+			name := goValueName(values.Get(i))
+			if comment, ok := comments[name]; ok && comment != "" {
+				enum.Descriptions = append(enum.Descriptions, AliasEntry{Key: name, Value: strconv.Quote(comment)})
+			}
+		}
+	}
+}
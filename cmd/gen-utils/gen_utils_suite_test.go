@@ -0,0 +1,15 @@
+package main
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Create a new test runner we'll use to test all the
+// modules in the gen-utils command
+func TestGenUtils(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GenUtils Suite")
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The template used to generate the code for all the enums in the package
+var codeTemplate = template.Must(template.New("utils").Funcs(template.FuncMap{
+	"codec":        codecName,
+	"private":      privateName,
+	"display":      displayName,
+	"csv":          csvOption,
+	"sql":          sqlOption,
+	"descriptions": descriptionsOption,
+	"collisions":   collisionsOption,
+}).Parse(`// Code generated by gen-utils from {{.Source}}. DO NOT EDIT.
+
+package gopb
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+{{- if .UsesFmt}}
+	"fmt"
+{{- end}}
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/xefino/protobuf-gen-go/utils"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+)
+{{range .Enums}}{{if .Alternates}}
+// {{private .}}Alternates contains alternative values for the {{display .}} enum
+var {{private .}}Alternates = map[string]{{.GoType}}{
+{{- range .Alternates}}
+	{{.Key}}: {{.Value}},
+{{- end}}
+}
+
+// {{.Prefix}}Alternates contains alternative values for the {{display .}} enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var {{.Prefix}}Alternates = copyTable({{private .}}Alternates)
+{{end}}{{if .Mapping}}
+// {{private .}}Mapping contains alternate names for the {{display .}} enum
+var {{private .}}Mapping = map[{{.GoType}}]string{
+{{- range .Mapping}}
+	{{.Key}}: {{.Value}},
+{{- end}}
+}
+
+// {{.Prefix}}Mapping contains alternate names for the {{display .}} enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var {{.Prefix}}Mapping = copyTable({{private .}}Mapping)
+{{end}}{{if .Codes}}
+// {{private .}}Codes contains the codes used for the values of the {{display .}} enum by each of the sources
+// that disseminate them. Each code is also included in the alternates, prefixed by the name of its source
+var {{private .}}Codes = map[string]map[string]{{.GoType}}{
+{{- range .Codes}}
+	{{.Name}}: {
+	{{- range .Codes}}
+		{{.Key}}: {{.Value}},
+	{{- end}}
+	},
+{{- end}}
+}
+{{end}}{{if .Descriptions}}
+// {{.Prefix}}Descriptions contains the descriptions of the values of the {{display .}} enum
+var {{.Prefix}}Descriptions = map[{{.GoType}}]string{
+{{- range .Descriptions}}
+	{{.Key}}: {{.Value}},
+{{- end}}
+}
+{{end}}{{end}}
+{{- range .Enums}}
+// {{codec .}} converts a {{display .}} to and from each of the supported formats
+var {{codec .}} = utils.NewEnumCodec[{{.GoType}}]({{if .Alternates}}{{private .}}Alternates{{else}}nil{{end}}, {{if .Mapping}}{{private .}}Mapping{{else}}nil{{end}}){{csv .}}{{sql .}}{{descriptions .}}{{collisions .}}
+
+// MarshalJSON converts a {{display .}} to JSON
+func (enum {{.GoType}}) MarshalJSON() ([]byte, error) {
+	return {{codec .}}.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a {{display .}} to a CSV cell value
+func (enum {{.GoType}}) MarshalCSV() (string, error) {
+	return {{codec .}}.EncodeCSV(enum)
+}
+
+// MarshalText converts a {{display .}} to text
+func (enum {{.GoType}}) MarshalText() ([]byte, error) {
+	return {{codec .}}.EncodeText(enum)
+}
+
+// MarshalYAML converts a {{display .}} to a YAML node value
+func (enum {{.GoType}}) MarshalYAML() (interface{}, error) {
+	return {{codec .}}.EncodeYAML(enum)
+}
+
+// MarshalXML converts a {{display .}} to an XML element
+func (enum {{.GoType}}) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return {{codec .}}.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a {{display .}} to an XML attribute
+func (enum {{.GoType}}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return {{codec .}}.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a {{display .}} to a BSON value
+func (enum {{.GoType}}) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return {{codec .}}.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a {{display .}} to a DynamoDB attribute value
+func (enum {{.GoType}}) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return {{codec .}}.EncodeDynamoDB(enum)
+}
+
+// Value converts a {{display .}} to an SQL value
+func (enum {{.GoType}}) Value() (driver.Value, error) {
+	return {{codec .}}.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalJSON(raw []byte) error {
+	return {{codec .}}.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalCSV(raw string) error {
+	return {{codec .}}.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalText(raw []byte) error {
+	return {{codec .}}.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalYAML(value *yaml.Node) error {
+	return {{codec .}}.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return {{codec .}}.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalXMLAttr(attr xml.Attr) error {
+	return {{codec .}}.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return {{codec .}}.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a {{display .}}
+func (enum *{{.GoType}}) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return {{codec .}}.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a {{display .}}
+func (enum *{{.GoType}}) Scan(value interface{}) error {
+	return {{codec .}}.DecodeSQL(value, enum)
+}
+{{end}}
+// Validate each codec when the package is initialized so that names which collide after normalization are
+// found when the program starts, rather than when one of them is first decoded
+func init() {
+	for _, codec := range []interface{ Validate() error }{
+{{- range .Enums}}
+		{{codec .}},
+{{- end}}
+	} {
+		if err := codec.Validate(); err != nil {
+			panic(err)
+		}
+	}
+}
+`))
+
+// Generate creates the formatted Go code for the enums provided. The source is the path to the alias file
+// the enums were read from, which will be recorded in the header of the generated code
+func Generate(source string, enums []*Enum) ([]byte, error) {
+
+	// First, verify the formats for each enum and determine whether any require the fmt package
+	var usesFmt bool
+	for _, enum := range enums {
+		switch {
+		case enum.CSV == "" || enum.CSV == "name" || enum.CSV == "number":
+		case strings.Contains(enum.CSV, "%"):
+			usesFmt = true
+		default:
+			return nil, fmt.Errorf("enum %s has an invalid CSV format of %q", enum.Enum, enum.CSV)
+		}
+	}
+
+	// Next, execute the template against the enums
+	var buffer bytes.Buffer
+	if err := codeTemplate.Execute(&buffer, map[string]interface{}{
+		"Source":  filepath.Base(source),
+		"UsesFmt": usesFmt,
+		"Enums":   enums,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to generate code, error: %v", err)
+	}
+
+	// Finally, format the generated code
+	code, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code, error: %v", err)
+	}
+
+	return code, nil
+}
+
+// Helper function that creates the name of the codec variable for an enum
+func codecName(enum *Enum) string {
+	return privateName(enum) + "Codec"
+}
+
+// Helper function that creates the unexported prefix used for the variables generated for an enum. The
+// alternates and mapping tables used by the codecs are unexported so they cannot be modified once the
+// package has been initialized; callers should use the alias registry to add names for an enum instead
+func privateName(enum *Enum) string {
+	first, size := utf8.DecodeRuneInString(enum.Prefix)
+	return string(unicode.ToLower(first)) + enum.Prefix[size:]
+}
+
+// Helper function that creates the name used to refer to an enum in comments
+func displayName(enum *Enum) string {
+	return enum.Enum
+}
+
+// Helper function that creates the option that sets the CSV format for an enum's codec, if it requires one
+func csvOption(enum *Enum) string {
+	switch enum.CSV {
+	case "", "name":
+		return ""
+	case "number":
+		return fmt.Sprintf(".\n\tWithCSV(utils.FormatNumber[%s])", enum.GoType)
+	default:
+		return fmt.Sprintf(".\n\tWithCSV(func(enum %s) string { return fmt.Sprintf(%q, enum) })", enum.GoType, enum.CSV)
+	}
+}
+
+// Helper function that creates the option that sets the SQL format for an enum's codec, if it requires one.
+// Any value other than name or number is assumed to be the name of a function in the package
+func sqlOption(enum *Enum) string {
+	switch enum.SQL {
+	case "", "name":
+		return ""
+	case "number":
+		return fmt.Sprintf(".\n\tWithSQL(utils.NumberValue[%s])", enum.GoType)
+	default:
+		return fmt.Sprintf(".\n\tWithSQL(%s)", enum.SQL)
+	}
+}
+
+// Helper function that creates the option that sets the descriptions for an enum's codec, if it has any
+func descriptionsOption(enum *Enum) string {
+	if len(enum.Descriptions) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(".\n\tWithDescriptions(%sDescriptions)", enum.Prefix)
+}
+
+// Helper function that creates the option that sets the normal forms an enum's codec allows to collide, if
+// it has any
+func collisionsOption(enum *Enum) string {
+	if len(enum.Collisions) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(enum.Collisions))
+	for i, form := range enum.Collisions {
+		quoted[i] = strconv.Quote(form)
+	}
+
+	return fmt.Sprintf(".\n\tWithAllowedCollisions(%s)", strings.Join(quoted, ", "))
+}
@@ -0,0 +1,67 @@
+// Command gen-utils generates the alias tables and the marshalling and unmarshalling methods for the enums
+// in the gopb package. The enums are read from the descriptors registered by the package's .pb.go files
+// and the aliases from a YAML file. The descriptions of the enum values are read from the comments in the
+// .pb.go files in the source directory. It is intended to be run with go generate, from the gopb directory:
+//
+//	go run ../cmd/gen-utils -aliases aliases.yaml -source . -output utils_gen.go
+//
+// As the generator imports the gopb package, the package must compile before the generator can be run. New
+// enums will not have any methods until the file is regenerated, but this does not prevent compilation
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	_ "github.com/xefino/protobuf-gen-go/gopb"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func main() {
+	aliases := flag.String("aliases", "aliases.yaml", "the YAML file containing the aliases for each enum")
+	source := flag.String("source", ".", "the directory containing the .pb.go files for the enums")
+	output := flag.String("output", "utils_gen.go", "the file the generated code should be written to")
+	flag.Parse()
+
+	if err := run(*aliases, *source, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "gen-utils: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Helper function that reads the alias file, generates the code and writes it to the output file
+func run(aliasPath string, sourceDir string, outputPath string) error {
+	raw, err := os.ReadFile(aliasPath)
+	if err != nil {
+		return fmt.Errorf("failed to read alias file, error: %v", err)
+	}
+
+	file, err := ParseAliasFile(raw)
+	if err != nil {
+		return fmt.Errorf("failed to parse alias file %s, error: %v", aliasPath, err)
+	}
+
+	enums, err := Resolve(file, protoregistry.GlobalFiles)
+	if err != nil {
+		return err
+	}
+
+	comments, err := ParseComments(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	AddDescriptions(enums, comments)
+
+	code, err := Generate(aliasPath, enums)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, code, 0644); err != nil {
+		return fmt.Errorf("failed to write %s, error: %v", outputPath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package gopb
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// PricePoint describes the price and volume associated with a single bar in a historical series
+type PricePoint struct {
+	Timestamp *UnixTimestamp
+	Price     *Decimal
+	Volume    *Decimal
+}
+
+// SplitEvent describes a stock split, where every SplitFrom shares held before the execution date
+// become SplitTo shares on the execution date
+type SplitEvent struct {
+	ExecutionDate *UnixTimestamp
+	SplitFrom     *Decimal
+	SplitTo       *Decimal
+}
+
+// AdjustmentFactor describes the adjustment associated with a single corporate action. The price and
+// volume factors apply to all bars before the date of the corporate action whereas the cumulative
+// factors include the factors for this corporate action and all those that came after it
+type AdjustmentFactor struct {
+	Date                   *UnixTimestamp
+	PriceFactor            *Decimal
+	VolumeFactor           *Decimal
+	CumulativePriceFactor  *Decimal
+	CumulativeVolumeFactor *Decimal
+}
+
+// PriceAdjuster generates backward-adjusted historical price series from splits and cash dividends.
+// All factors are tracked as exact ratios and are only rounded, to the precision associated with the
+// adjuster, when they are converted to Decimal values
+type PriceAdjuster struct {
+	Precision int32
+	types     map[Financial_Dividends_Type]bool
+}
+
+// Helper type that contains the exact price and volume ratios associated with a corporate action
+type adjustment struct {
+	date   *UnixTimestamp
+	price  *big.Rat
+	volume *big.Rat
+}
+
+// NewPriceAdjuster creates a new PriceAdjuster that rounds adjusted values to the precision provided and
+// that adjusts for the dividend types provided. If no dividend types are provided then every dividend
+// type that describes a cash distribution will be adjusted for
+func NewPriceAdjuster(precision int32, dividendTypes ...Financial_Dividends_Type) *PriceAdjuster {
+	adjuster := PriceAdjuster{
+		Precision: precision,
+		types:     make(map[Financial_Dividends_Type]bool),
+	}
+
+	for _, dividendType := range dividendTypes {
+		adjuster.types[dividendType] = true
+	}
+
+	if len(dividendTypes) == 0 {
+		for value := range Financial_Dividends_Type_name {
+			adjuster.types[Financial_Dividends_Type(value)] = Financial_Dividends_Type(value).IsCash()
+		}
+	}
+
+	return &adjuster
+}
+
+// IsCash returns true if the dividend type represents a distribution of cash to shareholders, or false
+// otherwise. Both regular (CD) and special (SC) cash dividends are considered cash distributions, as
+// are long-term (LT) and short-term (ST) capital gains distributions
+func (enum Financial_Dividends_Type) IsCash() bool {
+	switch enum {
+	case Financial_Dividends_CD, Financial_Dividends_SC, Financial_Dividends_LT, Financial_Dividends_ST:
+		return true
+	default:
+		return false
+	}
+}
+
+// Factors calculates the adjustment factors associated with the splits and dividends provided. The
+// bars are used to determine the closing price before each ex-dividend date so they should contain
+// unadjusted prices. The factors will be returned in ascending order of their dates. A utils.RangeError
+// will be returned if a split ratio, dividend amount or price before a dividend is not positive, or if
+// a dividend is not less than the price before it
+func (adjuster *PriceAdjuster) Factors(bars []*PricePoint, splits []*SplitEvent,
+	dividends []*DividendEvent) ([]*AdjustmentFactor, error) {
+
+	// First, calculate the exact adjustments associated with each corporate action
+	adjustments, err := adjuster.adjustments(bars, splits, dividends)
+	if err != nil {
+		return nil, err
+	}
+
+	// Next, convert the adjustments to factors and return them
+	return adjuster.factors(adjustments), nil
+}
+
+// Helper function that converts exact adjustments, sorted in ascending order of their dates, to
+// adjustment factors by iterating over them backwards in time and accumulating the factors as we go
+func (adjuster *PriceAdjuster) factors(adjustments []*adjustment) []*AdjustmentFactor {
+	factors := make([]*AdjustmentFactor, len(adjustments))
+	cumPrice, cumVolume := big.NewRat(1, 1), big.NewRat(1, 1)
+	for i := len(adjustments) - 1; i >= 0; i-- {
+		adj := adjustments[i]
+		cumPrice.Mul(cumPrice, adj.price)
+		cumVolume.Mul(cumVolume, adj.volume)
+		factors[i] = &AdjustmentFactor{
+			Date:                   adj.date,
+			PriceFactor:            adjuster.fromRat(adj.price),
+			VolumeFactor:           adjuster.fromRat(adj.volume),
+			CumulativePriceFactor:  adjuster.fromRat(cumPrice),
+			CumulativeVolumeFactor: adjuster.fromRat(cumVolume),
+		}
+	}
+
+	return factors
+}
+
+// Adjust generates a backward-adjusted copy of the bars provided from the splits and dividends. The
+// bars are expected to contain unadjusted prices and volumes. The adjusted bars will be returned in
+// the same order as the bars provided along with the adjustment factors used to generate them. This
+// function returns the same errors as Factors
+func (adjuster *PriceAdjuster) Adjust(bars []*PricePoint, splits []*SplitEvent,
+	dividends []*DividendEvent) ([]*PricePoint, []*AdjustmentFactor, error) {
+
+	// First, calculate the exact adjustments associated with each corporate action
+	adjustments, err := adjuster.adjustments(bars, splits, dividends)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Next, iterate over all the bars and apply every adjustment that occurs after the bar to it. Since
+	// the number of corporate actions is typically small we just do this directly
+	adjusted := make([]*PricePoint, len(bars))
+	for i, bar := range bars {
+		price, volume := big.NewRat(1, 1), big.NewRat(1, 1)
+		for _, adj := range adjustments {
+			if bar.Timestamp.LessThan(adj.date) {
+				price.Mul(price, adj.price)
+				volume.Mul(volume, adj.volume)
+			}
+		}
+
+		adjusted[i] = &PricePoint{
+			Timestamp: bar.Timestamp.Copy(),
+			Price:     adjuster.fromRat(price.Mul(price, toRat(bar.Price))),
+			Volume:    adjuster.fromRat(volume.Mul(volume, toRat(bar.Volume))),
+		}
+	}
+
+	// Finally, return the adjusted bars and the factors
+	return adjusted, adjuster.factors(adjustments), nil
+}
+
+// Helper function that calculates the exact price and volume ratios associated with every split and
+// dividend, returning them in ascending order of their dates
+func (adjuster *PriceAdjuster) adjustments(bars []*PricePoint, splits []*SplitEvent,
+	dividends []*DividendEvent) ([]*adjustment, error) {
+
+	// First, sort a copy of the bars by their timestamps so we can find the last price before each
+	// ex-dividend date
+	sorted := append([]*PricePoint(nil), bars...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.LessThan(sorted[j].Timestamp)
+	})
+
+	// Next, calculate the ratios associated with each split; a split of from-for-to shares multiplies
+	// historical prices by from/to and historical volumes by to/from
+	adjustments := make([]*adjustment, 0, len(splits)+len(dividends))
+	for _, split := range splits {
+		from, to := toRat(split.SplitFrom), toRat(split.SplitTo)
+		if from.Sign() <= 0 {
+			return nil, &utils.RangeError{Type: fmt.Sprintf("shares before the split on %s", split.ExecutionDate.ToDate()),
+				Value: from.RatString(), Bound: "0 (exclusive)", Err: utils.ErrUnderflow}
+		} else if to.Sign() <= 0 {
+			return nil, &utils.RangeError{Type: fmt.Sprintf("shares after the split on %s", split.ExecutionDate.ToDate()),
+				Value: to.RatString(), Bound: "0 (exclusive)", Err: utils.ErrUnderflow}
+		}
+
+		adjustments = append(adjustments, &adjustment{
+			date:   split.ExecutionDate.DayDown(),
+			price:  new(big.Rat).Quo(from, to),
+			volume: new(big.Rat).Quo(to, from),
+		})
+	}
+
+	// Now, calculate the ratios associated with each dividend we're adjusting for. A cash dividend of
+	// A, where the last price before the ex-dividend date was P, multiplies historical prices by
+	// (P - A) / P and does not affect volumes. Dividends with no price before them are ignored, and both
+	// P and A must be positive
+	for _, dividend := range dividends {
+		if !adjuster.types[dividend.Type] {
+			continue
+		}
+
+		exDate := dividend.ExDividendDate.DayDown()
+		index := sort.Search(len(sorted), func(i int) bool {
+			return !sorted[i].Timestamp.LessThan(exDate)
+		})
+
+		if index == 0 {
+			continue
+		}
+
+		price, amount := toRat(sorted[index-1].Price), toRat(dividend.CashAmount)
+		if price.Sign() <= 0 {
+			return nil, &utils.RangeError{Type: fmt.Sprintf("price before the dividend on %s", exDate.ToDate()),
+				Value: adjuster.fromRat(price).ToString(), Bound: "0 (exclusive)", Err: utils.ErrUnderflow}
+		} else if amount.Sign() <= 0 {
+			return nil, &utils.RangeError{Type: fmt.Sprintf("amount of the dividend on %s", exDate.ToDate()),
+				Value: adjuster.fromRat(amount).ToString(), Bound: "0 (exclusive)", Err: utils.ErrUnderflow}
+		} else if amount.Cmp(price) >= 0 {
+			return nil, &utils.RangeError{Type: fmt.Sprintf("amount of the dividend on %s", exDate.ToDate()),
+				Value: adjuster.fromRat(amount).ToString(),
+				Bound: adjuster.fromRat(price).ToString() + " (exclusive)", Err: utils.ErrOverflow}
+		}
+
+		adjustments = append(adjustments, &adjustment{
+			date:   exDate,
+			price:  new(big.Rat).Quo(new(big.Rat).Sub(price, amount), price),
+			volume: big.NewRat(1, 1),
+		})
+	}
+
+	// Finally, sort the adjustments by date and return them
+	sort.SliceStable(adjustments, func(i, j int) bool {
+		return adjustments[i].date.LessThan(adjustments[j].date)
+	})
+
+	return adjustments, nil
+}
+
+// Helper function that converts an exact ratio to a Decimal, rounded to the precision of the adjuster
+func (adjuster *PriceAdjuster) fromRat(value *big.Rat) *Decimal {
+	num := decimal.NewFromBigInt(value.Num(), 0)
+	denom := decimal.NewFromBigInt(value.Denom(), 0)
+	return NewFromDecimal(num.DivRound(denom, adjuster.Precision))
+}
+
+// Helper function that converts a Decimal to an exact ratio, treating a nil Decimal as zero
+func toRat(value *Decimal) *big.Rat {
+	if value == nil {
+		return new(big.Rat)
+	}
+
+	return value.ToDecimal().Rat()
+}
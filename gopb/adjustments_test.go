@@ -0,0 +1,149 @@
+package gopb
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Price Adjustment Tests", func() {
+
+	// Helper function that creates the unadjusted bars we'll use for testing
+	bars := func() []*PricePoint {
+		return []*PricePoint{
+			{Timestamp: date(2022, time.January, 3), Price: dec("100"), Volume: dec("1000")},
+			{Timestamp: date(2022, time.January, 4), Price: dec("102"), Volume: dec("1200")},
+			{Timestamp: date(2022, time.January, 5), Price: dec("50"), Volume: dec("2000")},
+			{Timestamp: date(2022, time.January, 6), Price: dec("51"), Volume: dec("2100")},
+			{Timestamp: date(2022, time.January, 7), Price: dec("50"), Volume: dec("2200")},
+		}
+	}
+
+	// The splits and dividends we'll use for testing
+	splits := []*SplitEvent{{ExecutionDate: date(2022, time.January, 5), SplitFrom: dec("1"), SplitTo: dec("2")}}
+	dividends := []*DividendEvent{
+		{ExDividendDate: date(2022, time.January, 7), CashAmount: dec("0.5"), Type: Financial_Dividends_CD},
+		{ExDividendDate: date(2022, time.January, 6), CashAmount: dec("1"), Type: Financial_Dividends_SC},
+		{ExDividendDate: date(2022, time.January, 4), CashAmount: dec("3"), Type: Financial_Dividends_NP},
+	}
+
+	// Tests that the IsCash function works for all dividend types
+	DescribeTable("Financial.Dividends.Type.IsCash - Works",
+		func(dividendType Financial_Dividends_Type, expected bool) {
+			Expect(dividendType.IsCash()).Should(Equal(expected))
+		},
+		Entry("CD - True", Financial_Dividends_CD, true),
+		Entry("SC - True", Financial_Dividends_SC, true),
+		Entry("LT - True", Financial_Dividends_LT, true),
+		Entry("ST - True", Financial_Dividends_ST, true),
+		Entry("NP - False", Financial_Dividends_NP, false))
+
+	// Tests that the Factors function returns an error if a split has an invalid ratio
+	It("Factors - Split ratio invalid - Error", func() {
+		adjuster := NewPriceAdjuster(6)
+		factors, err := adjuster.Factors(bars(), []*SplitEvent{
+			{ExecutionDate: date(2022, time.January, 5), SplitFrom: dec("0"), SplitTo: dec("2")},
+		}, nil)
+		Expect(err).Should(HaveOccurred())
+		Expect(errors.Is(err, utils.ErrUnderflow)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("shares before the split on 2022-01-05 (0) is less than the minimum of 0 (exclusive)"))
+		Expect(factors).Should(BeNil())
+	})
+
+	// Tests that the Factors function returns an error if a dividend is at least as large as the price
+	It("Factors - Dividend exceeds price - Error", func() {
+		adjuster := NewPriceAdjuster(6)
+		factors, err := adjuster.Factors(bars(), nil, []*DividendEvent{
+			{ExDividendDate: date(2022, time.January, 7), CashAmount: dec("51"), Type: Financial_Dividends_SC},
+		})
+		Expect(err).Should(HaveOccurred())
+		Expect(errors.Is(err, utils.ErrOverflow)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal(
+			"amount of the dividend on 2022-01-07 (51) is greater than the maximum of 51 (exclusive)"))
+		Expect(factors).Should(BeNil())
+	})
+
+	// Tests that the Factors function returns an error if a dividend amount or the price before it is invalid
+	DescribeTable("Factors - Dividend invalid - Error",
+		func(price string, amount *Decimal, message string) {
+			points := bars()
+			points[3].Price = dec(price)
+
+			adjuster := NewPriceAdjuster(6)
+			factors, err := adjuster.Factors(points, nil, []*DividendEvent{
+				{ExDividendDate: date(2022, time.January, 7), CashAmount: amount, Type: Financial_Dividends_CD},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(errors.Is(err, utils.ErrUnderflow)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal(message))
+			Expect(factors).Should(BeNil())
+		},
+		Entry("Amount negative, price zero - Error", "0", dec("-0.5"),
+			"price before the dividend on 2022-01-07 (0) is less than the minimum of 0 (exclusive)"),
+		Entry("Price negative - Error", "-1", dec("0.5"),
+			"price before the dividend on 2022-01-07 (-1) is less than the minimum of 0 (exclusive)"),
+		Entry("Amount negative - Error", "51", dec("-0.5"),
+			"amount of the dividend on 2022-01-07 (-0.5) is less than the minimum of 0 (exclusive)"),
+		Entry("Amount zero - Error", "51", dec("0"),
+			"amount of the dividend on 2022-01-07 (0) is less than the minimum of 0 (exclusive)"),
+		Entry("Amount missing - Error", "51", nil,
+			"amount of the dividend on 2022-01-07 (0) is less than the minimum of 0 (exclusive)"))
+
+	// Tests that the Factors function generates the cumulative adjustment factor table
+	It("Factors - Works", func() {
+
+		// First, calculate the factors for our splits and dividends
+		adjuster := NewPriceAdjuster(6)
+		factors, err := adjuster.Factors(bars(), splits, dividends)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Next, verify the factor associated with the split
+		Expect(factors).Should(HaveLen(3))
+		Expect(factors[0].Date).Should(Equal(date(2022, time.January, 5)))
+		Expect(factors[0].PriceFactor.ToString()).Should(Equal("0.5"))
+		Expect(factors[0].VolumeFactor.ToString()).Should(Equal("2"))
+		Expect(factors[0].CumulativePriceFactor.ToString()).Should(Equal("0.485196"))
+		Expect(factors[0].CumulativeVolumeFactor.ToString()).Should(Equal("2"))
+
+		// Now, verify the factor associated with the special dividend
+		Expect(factors[1].Date).Should(Equal(date(2022, time.January, 6)))
+		Expect(factors[1].PriceFactor.ToString()).Should(Equal("0.98"))
+		Expect(factors[1].VolumeFactor.ToString()).Should(Equal("1"))
+		Expect(factors[1].CumulativePriceFactor.ToString()).Should(Equal("0.970392"))
+		Expect(factors[1].CumulativeVolumeFactor.ToString()).Should(Equal("1"))
+
+		// Finally, verify the factor associated with the regular dividend
+		Expect(factors[2].Date).Should(Equal(date(2022, time.January, 7)))
+		Expect(factors[2].PriceFactor.ToString()).Should(Equal("0.990196"))
+		Expect(factors[2].VolumeFactor.ToString()).Should(Equal("1"))
+		Expect(factors[2].CumulativePriceFactor.ToString()).Should(Equal("0.990196"))
+		Expect(factors[2].CumulativeVolumeFactor.ToString()).Should(Equal("1"))
+	})
+
+	// Tests that the Adjust function generates backward-adjusted prices and volumes
+	DescribeTable("Adjust - Works",
+		func(adjuster *PriceAdjuster, prices []string, volumes []string, numFactors int) {
+
+			// First, adjust the bars for our splits and dividends
+			adjusted, factors, err := adjuster.Adjust(bars(), splits, dividends)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(factors).Should(HaveLen(numFactors))
+
+			// Next, verify the adjusted bars
+			Expect(adjusted).Should(HaveLen(len(prices)))
+			for i, bar := range adjusted {
+				Expect(bar.Timestamp).Should(Equal(bars()[i].Timestamp))
+				Expect(bar.Price.ToString()).Should(Equal(prices[i]))
+				Expect(bar.Volume.ToString()).Should(Equal(volumes[i]))
+			}
+		},
+		Entry("All cash dividends - Adjusted", NewPriceAdjuster(6),
+			[]string{"48.519608", "49.49", "48.519608", "50.5", "50"},
+			[]string{"2000", "2400", "2000", "2100", "2200"}, 3),
+		Entry("Regular dividends only - Adjusted", NewPriceAdjuster(6, Financial_Dividends_CD),
+			[]string{"49.509804", "50.5", "49.509804", "50.5", "50"},
+			[]string{"2000", "2400", "2000", "2100", "2200"}, 2))
+})
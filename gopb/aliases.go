@@ -0,0 +1,164 @@
+package gopb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/xefino/protobuf-gen-go/utils"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ProviderEnum describes the protobuf enums that can have provider-specific aliases registered for them
+type ProviderEnum interface {
+	~int32
+	protoreflect.Enum
+}
+
+// Aliases contains the alternate names that should be accepted when decoding an enum and the names that
+// should be produced when encoding it
+type Aliases[T ProviderEnum] struct {
+	Alternates map[string]T
+	Mapping    map[T]string
+}
+
+// Helper type used to identify the aliases registered for an enum by a provider
+type aliasKey struct {
+	provider Provider
+	enum     reflect.Type
+}
+
+// Helper type containing the aliases registered for an enum by a provider, along with an index of the
+// normal forms of their alternate names
+type registeredAliases[T ProviderEnum] struct {
+	aliases Aliases[T]
+	index   *utils.NameIndex[T]
+}
+
+// The aliases registered for each provider and enum. Readers load the current table without locking;
+// writers hold the lock, copy the table and swap in the copy so that a table is never modified after
+// it has been published
+var (
+	aliasLock     sync.Mutex
+	aliasRegistry atomic.Value
+)
+
+func init() {
+	aliasRegistry.Store(make(map[aliasKey]interface{}))
+}
+
+// RegisterAliases adds aliases for an enum to those already registered for the provider. Where an alias
+// was already registered, the new value replaces it. The tables provided are copied so they may be
+// modified by the caller afterwards without affecting the registry. This function is safe to call
+// concurrently with itself and with any of the lookup, encode and decode functions
+func RegisterAliases[T ProviderEnum](provider Provider, aliases Aliases[T]) {
+	aliasLock.Lock()
+	defer aliasLock.Unlock()
+
+	// First, merge the new aliases into a copy of any aliases already registered for the provider
+	key := aliasKey{provider: provider, enum: enumType[T]()}
+	current := aliasRegistry.Load().(map[aliasKey]interface{})
+	existing, _ := current[key].(registeredAliases[T])
+	merged := Aliases[T]{
+		Alternates: make(map[string]T, len(existing.aliases.Alternates)+len(aliases.Alternates)),
+		Mapping:    make(map[T]string, len(existing.aliases.Mapping)+len(aliases.Mapping)),
+	}
+
+	for _, alternates := range []map[string]T{existing.aliases.Alternates, aliases.Alternates} {
+		for name, value := range alternates {
+			merged.Alternates[name] = value
+		}
+	}
+
+	for _, mapping := range []map[T]string{existing.aliases.Mapping, aliases.Mapping} {
+		for value, name := range mapping {
+			merged.Mapping[value] = name
+		}
+	}
+
+	// Next, copy the registry, add the merged aliases and their index to it and publish the copy
+	updated := make(map[aliasKey]interface{}, len(current)+1)
+	for k, v := range current {
+		updated[k] = v
+	}
+
+	updated[key] = registeredAliases[T]{
+		aliases: merged,
+		index:   utils.NewNameIndex(utils.DefaultNormalizer, merged.Alternates),
+	}
+
+	aliasRegistry.Store(updated)
+}
+
+// LookupAliases retrieves the aliases registered for an enum by a provider, returning false if the
+// provider has not registered any. The tables returned must not be modified
+func LookupAliases[T ProviderEnum](provider Provider) (Aliases[T], bool) {
+	registered, ok := lookupRegistered[T](provider)
+	return registered.aliases, ok
+}
+
+// EncodeEnum converts an enum value to the name used for it by a provider. If the provider has not
+// registered a name for the value then it will be encoded by the enum's codec, which uses the default
+// name, followed by the protobuf name. If neither of these exist then the value will be formatted as an
+// integer
+func EncodeEnum[T ProviderEnum](provider Provider, value T) string {
+	if registered, ok := lookupRegistered[T](provider); ok {
+		if name, ok := registered.aliases.Mapping[value]; ok {
+			return name
+		}
+	}
+
+	return utils.Format(value)
+}
+
+// DecodeEnum converts a name used by a provider to its associated enum value. The name is checked against
+// the provider's aliases first, exactly and then by its normal form. If it matches none of these then it
+// will be decoded by the enum's codec, in the same way as it would be decoded from CSV, so the protobuf
+// names, default aliases, normalization and the global decoding mode all apply
+func DecodeEnum[T ProviderEnum](provider Provider, raw string) (T, error) {
+	name := strings.TrimSpace(raw)
+	if registered, ok := lookupRegistered[T](provider); ok {
+		if value, ok := registered.aliases.Alternates[name]; ok {
+			return value, nil
+		}
+
+		value, ok, err := registered.index.Lookup(name)
+		if err != nil {
+			return value, fmt.Errorf("%w for provider %s", err, provider)
+		} else if ok {
+			return value, nil
+		}
+	}
+
+	value, err := utils.Parse[T](name)
+	if err != nil {
+		return value, fmt.Errorf("%w for provider %s", err, provider)
+	}
+
+	return value, nil
+}
+
+// Helper function that retrieves the aliases, and their index, registered for an enum by a provider
+func lookupRegistered[T ProviderEnum](provider Provider) (registeredAliases[T], bool) {
+	current := aliasRegistry.Load().(map[aliasKey]interface{})
+	registered, ok := current[aliasKey{provider: provider, enum: enumType[T]()}].(registeredAliases[T])
+	return registered, ok
+}
+
+// Helper function that retrieves the type of an enum so it can be used as a registry key
+func enumType[T ProviderEnum]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Helper function that copies one of the generated alternates or mapping tables so that it can be
+// exported without allowing the table used by the codec to be modified
+func copyTable[K comparable, V any](table map[K]V) map[K]V {
+	copied := make(map[K]V, len(table))
+	for key, value := range table {
+		copied[key] = value
+	}
+
+	return copied
+}
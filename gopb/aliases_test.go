@@ -0,0 +1,159 @@
+package gopb
+
+import (
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alias Registry Tests", func() {
+
+	// Tests that, when a provider has not registered any aliases, the defaults are used
+	DescribeTable("EncodeEnum, DecodeEnum - Defaults - Works",
+		func(raw string, expected Financial_Common_AssetClass, encoded string) {
+			value, err := DecodeEnum[Financial_Common_AssetClass](Provider_None, raw)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value).Should(Equal(expected))
+			Expect(EncodeEnum(Provider_None, value)).Should(Equal(encoded))
+		},
+		Entry("Protobuf name - Works", "Stock", Financial_Common_Stock, "Stock"),
+		Entry("Alternate name - Works", "stocks", Financial_Common_Stock, "Stock"),
+		Entry("Mapped name - Works", "OTC", Financial_Common_OverTheCounter, "OTC"),
+		Entry("Integer - Works", "3", Financial_Common_ForeignExchange, "Foreign Exchange"),
+		Entry("Unknown integer - Works", "99", Financial_Common_AssetClass(99), "99"))
+
+	// Tests that aliases registered for a provider are used for that provider only, and that the defaults
+	// are used for any values the provider has not registered
+	It("RegisterAliases - Provider-specific aliases - Works", func() {
+		RegisterAliases(Provider_Polygon, Aliases[Financial_Trades_CorrectionCode]{
+			Alternates: map[string]Financial_Trades_CorrectionCode{"orig": Financial_Trades_NotCorrected},
+			Mapping:    map[Financial_Trades_CorrectionCode]string{Financial_Trades_NotCorrected: "orig"},
+		})
+
+		// Register a second set of aliases for the same enum; these should be merged with the first
+		RegisterAliases(Provider_Polygon, Aliases[Financial_Trades_CorrectionCode]{
+			Alternates: map[string]Financial_Trades_CorrectionCode{"late": Financial_Trades_LateCorrected},
+		})
+
+		aliases, ok := LookupAliases[Financial_Trades_CorrectionCode](Provider_Polygon)
+		Expect(ok).Should(BeTrue())
+		Expect(aliases.Alternates).Should(HaveLen(2))
+		Expect(aliases.Mapping).Should(HaveLen(1))
+
+		value, err := DecodeEnum[Financial_Trades_CorrectionCode](Provider_Polygon, "orig")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).Should(Equal(Financial_Trades_NotCorrected))
+		Expect(EncodeEnum(Provider_Polygon, value)).Should(Equal("orig"))
+
+		value, err = DecodeEnum[Financial_Trades_CorrectionCode](Provider_Polygon, "late")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).Should(Equal(Financial_Trades_LateCorrected))
+		Expect(EncodeEnum(Provider_Polygon, value)).Should(Equal(tradeCorrectionMapping[Financial_Trades_LateCorrected]))
+
+		// The aliases should not be visible to other providers
+		_, err = DecodeEnum[Financial_Trades_CorrectionCode](Provider_None, "orig")
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("value of \"orig\" cannot be mapped to a " +
+			"gopb.Financial_Trades_CorrectionCode for provider None"))
+		Expect(EncodeEnum(Provider_None, Financial_Trades_NotCorrected)).ShouldNot(Equal("orig"))
+
+		// Nor should they be visible for other enums
+		_, ok = LookupAliases[Financial_Trades_Condition](Provider_Polygon)
+		Expect(ok).Should(BeFalse())
+	})
+
+	// Tests that names which don't match a provider's aliases or the enum's names exactly are decoded by
+	// their normal form, both for the provider's aliases and for the enum's codec
+	DescribeTable("DecodeEnum - Case and punctuation variants - Works",
+		func(provider Provider, raw string, decode func(Provider, string) (interface{}, error), expected interface{}) {
+			value, err := decode(provider, raw)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value).Should(Equal(expected))
+		},
+		Entry("Upper case alternate - Works", Provider_Polygon, "STOCKS",
+			decodeAs[Financial_Common_AssetClass], Financial_Common_Stock),
+		Entry("Upper case protobuf name - Works", Provider_Polygon, "FOREIGN-EXCHANGE",
+			decodeAs[Financial_Common_AssetClass], Financial_Common_ForeignExchange),
+		Entry("Hyphenated name - Works", Provider_Polygon, "Common-Share",
+			decodeAs[Financial_Common_AssetType], Financial_Common_CommonShare),
+		Entry("Spaced name - Works", Provider_None, " common share ",
+			decodeAs[Financial_Common_AssetType], Financial_Common_CommonShare))
+
+	// Tests that a provider's aliases are matched by their normal form when they don't match exactly
+	It("DecodeEnum - Provider alias variants - Works", func() {
+		RegisterAliases(Provider_Polygon, Aliases[Financial_Options_UnderlyingType]{
+			Alternates: map[string]Financial_Options_UnderlyingType{"equity_underlying": Financial_Options_Equity},
+		})
+
+		value, err := DecodeEnum[Financial_Options_UnderlyingType](Provider_Polygon, "Equity-Underlying")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).Should(Equal(Financial_Options_Equity))
+	})
+
+	// Tests that the exported tables are copies of the tables used by the codec, so modifying them has no
+	// effect on decoding
+	It("DecodeEnum - Deprecated tables copied - Works", func() {
+		Expect(AssetClassAlternates).Should(Equal(assetClassAlternates))
+		Expect(AssetClassMapping).Should(Equal(assetClassMapping))
+
+		AssetClassAlternates["copied"] = Financial_Common_Stock
+		defer delete(AssetClassAlternates, "copied")
+		Expect(assetClassAlternates).ShouldNot(HaveKey("copied"))
+
+		_, err := DecodeEnum[Financial_Common_AssetClass](Provider_None, "copied")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	// Tests that tables returned by LookupAliases are not affected by later registrations
+	It("LookupAliases - Copy on write - Works", func() {
+		RegisterAliases(Provider_Polygon, Aliases[Financial_Common_Locale]{
+			Alternates: map[string]Financial_Common_Locale{"usa": Financial_Common_US},
+		})
+
+		before, ok := LookupAliases[Financial_Common_Locale](Provider_Polygon)
+		Expect(ok).Should(BeTrue())
+
+		RegisterAliases(Provider_Polygon, Aliases[Financial_Common_Locale]{
+			Alternates: map[string]Financial_Common_Locale{"world": Financial_Common_Global},
+		})
+
+		after, _ := LookupAliases[Financial_Common_Locale](Provider_Polygon)
+		Expect(before.Alternates).Should(HaveLen(1))
+		Expect(after.Alternates).Should(HaveLen(2))
+	})
+
+	// Tests that aliases can be registered and used concurrently
+	It("RegisterAliases - Concurrent access - Works", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				RegisterAliases(Provider_Polygon, Aliases[Financial_Exchanges_Type]{
+					Alternates: map[string]Financial_Exchanges_Type{
+						fmt.Sprintf("type-%d", i): Financial_Exchanges_Type(i % 3),
+					},
+				})
+			}(i)
+
+			go func() {
+				defer wg.Done()
+				_, err := DecodeEnum[Financial_Exchanges_Type](Provider_Polygon, "1")
+				Expect(err).ShouldNot(HaveOccurred())
+			}()
+		}
+
+		wg.Wait()
+		aliases, ok := LookupAliases[Financial_Exchanges_Type](Provider_Polygon)
+		Expect(ok).Should(BeTrue())
+		Expect(aliases.Alternates).Should(HaveLen(20))
+	})
+})
+
+// Helper function that decodes a name to an enum value, returning the value as an interface so that
+// entries for different enums can share a table
+func decodeAs[T ProviderEnum](provider Provider, raw string) (interface{}, error) {
+	return DecodeEnum[T](provider, raw)
+}
@@ -0,0 +1,350 @@
+package gopb
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// Trade describes a single trade print as it would be reported by a feed
+type Trade struct {
+	ID             string
+	SequenceNumber int64
+	Timestamp      *UnixTimestamp
+	Price          *Decimal
+	Size           *Decimal
+	Conditions     []Financial_Trades_Condition
+	Correction     Financial_Trades_CorrectionCode
+	Tape           Financial_Common_Tape
+}
+
+// Bar describes the aggregate of all the trades that occurred over an interval of time. The prices on
+// the bar will be nil if no trade that occurred during the interval was eligible to update them. The
+// revision is incremented each time a bar that has already been emitted is amended
+type Bar struct {
+	Start      *UnixTimestamp
+	End        *UnixTimestamp
+	Open       *Decimal
+	High       *Decimal
+	Low        *Decimal
+	Close      *Decimal
+	Volume     *Decimal
+	VWAP       *Decimal
+	TradeCount int64
+	Revision   int
+}
+
+// BarBuilder aggregates a stream of trades into bars of a fixed interval. Bars are emitted when a trade
+// that falls after them is received or when the builder is flushed. Trades that arrive late, either
+// because they were reported out of sequence or because the feed delivered them late, will amend the
+// bars that have already been emitted so long as they arrive within the lateness window
+type BarBuilder struct {
+	Precision int32
+	interval  time.Duration
+	lateness  time.Duration
+	watermark *UnixTimestamp
+	bars      map[barKey]*barState
+}
+
+// Helper type used to key bars by their start time
+type barKey struct {
+	seconds int64
+	nanos   int32
+}
+
+// Helper type that contains the working state of a bar
+type barState struct {
+	start     *UnixTimestamp
+	open      decimal.Decimal
+	openTime  *UnixTimestamp
+	high      decimal.Decimal
+	low       decimal.Decimal
+	hasRange  bool
+	close     decimal.Decimal
+	closeTime *UnixTimestamp
+	volume    decimal.Decimal
+	notional  decimal.Decimal
+	count     int64
+	emitted   bool
+	revision  int
+}
+
+// NewBarBuilder creates a new BarBuilder that aggregates trades into bars of the interval provided and
+// that will accept trades for bars that started up to the lateness provided before the latest bar. A
+// negative lateness allows bars to be amended indefinitely. An error will be returned if the interval is
+// not positive
+func NewBarBuilder(interval time.Duration, lateness time.Duration) (*BarBuilder, error) {
+	if interval <= 0 {
+		return nil, &utils.RangeError{Type: "bar interval", Value: interval.String(), Bound: "1ns",
+			Err: utils.ErrUnderflow}
+	}
+
+	return &BarBuilder{
+		Precision: 10,
+		interval:  interval,
+		lateness:  lateness,
+		bars:      make(map[barKey]*barState),
+	}, nil
+}
+
+// AggregateBars aggregates a batch of trades into bars of the interval provided, returning the bars in
+// ascending order of their start times. This function can be used to re-aggregate bars after the set
+// of trades has been changed by a correction or cancellation. If the interval is not positive then no
+// bars will be returned
+func AggregateBars(interval time.Duration, trades []*Trade) []*Bar {
+	builder, err := NewBarBuilder(interval, -1)
+	if err != nil {
+		return nil
+	}
+
+	for _, trade := range trades {
+		builder.apply(trade, builder.Align(trade.Timestamp))
+	}
+
+	return builder.Flush()
+}
+
+// Align returns the start of the bar into which the timestamp would be aggregated. The timestamp is
+// first snapped down to the largest of the day, hour, minute or second that fits evenly into the interval,
+// after which it is snapped down to a whole multiple of the interval since the UNIX epoch. Timestamps
+// before the epoch are snapped down as well, rather than towards the epoch
+func (builder *BarBuilder) Align(timestamp *UnixTimestamp) *UnixTimestamp {
+
+	// First, snap the timestamp down to the largest unit that fits evenly into the interval
+	var base *UnixTimestamp
+	switch {
+	case builder.interval%(secondsInDay*time.Second) == 0:
+		base = floorUnit(timestamp, timestamp.DayDown(), secondsInDay)
+	case builder.interval%(secondsInHour*time.Second) == 0:
+		base = floorUnit(timestamp, timestamp.HourDown(), secondsInHour)
+	case builder.interval%(secondsInMinute*time.Second) == 0:
+		base = floorUnit(timestamp, timestamp.MinuteDown(), secondsInMinute)
+	case builder.interval%time.Second == 0:
+		base = timestamp.SecondDown()
+	default:
+		base = timestamp.Copy()
+	}
+
+	// Next, determine how far past a whole multiple of the interval the timestamp is. This is done with
+	// the seconds and nanoseconds separately because the timestamp, in nanoseconds, will not fit in an
+	// int64 outside of the years 1677 to 2262
+	interval := uint64(builder.interval)
+	seconds := base.Seconds % int64(interval)
+	if seconds < 0 {
+		seconds += int64(interval)
+	}
+
+	hi, lo := bits.Mul64(uint64(seconds), nanosPerSecond)
+	remainder := (bits.Rem64(hi, lo, interval) + uint64(base.Nanoseconds)) % interval
+
+	// Finally, remove the remainder from the timestamp
+	return base.AddDuration(NewFromDuration(-time.Duration(remainder)))
+}
+
+// Helper function that corrects a timestamp snapped down to a whole number of units by one of the *Down
+// functions. These truncate towards the epoch, so a timestamp before the epoch that was not already a
+// whole number of units will have been snapped up instead and must be moved back by one unit
+func floorUnit(timestamp *UnixTimestamp, snapped *UnixTimestamp, unit int64) *UnixTimestamp {
+	if snapped.GreaterThan(timestamp) {
+		snapped.Seconds -= unit
+	}
+
+	return snapped
+}
+
+// Add adds a trade to the builder, returning any bars that were completed or amended as a result. Only
+// the statistics which the trade's conditions allow it to update will be updated and trades that were
+// later cancelled or marked as erroneous, as well as the cancel, error and correction records that
+// follow them, will be ignored. An error will be returned if the trade falls outside the lateness
+// window of the builder
+func (builder *BarBuilder) Add(trade *Trade) ([]*Bar, error) {
+
+	// First, determine which bar the trade belongs in. If the trade is too late to be added to the bar
+	// then return an error
+	start := builder.Align(trade.Timestamp)
+	if builder.watermark != nil && start.LessThan(builder.cutoff()) {
+		return nil, fmt.Errorf("trade at %s is too late to be aggregated into a bar starting at %s",
+			trade.Timestamp.ToEpoch(), start.ToEpoch())
+	}
+
+	// Next, if the trade starts a new bar then emit every bar that hasn't been emitted yet, update our
+	// watermark and remove any bars which can no longer be amended
+	var emitted []*Bar
+	if builder.watermark == nil || start.GreaterThan(builder.watermark) {
+		emitted = builder.emit()
+		builder.watermark = start
+		builder.prune()
+	}
+
+	// Now, update the bar associated with the trade; if the trade isn't eligible to update the bar at
+	// all then we're done
+	bar := builder.apply(trade, start)
+	if bar == nil {
+		return emitted, nil
+	}
+
+	// Finally, if the bar was created or modified after the watermark moved past it then it needs to be
+	// emitted again so that consumers can amend their copies
+	if start.LessThan(builder.watermark) {
+		if bar.emitted {
+			bar.revision++
+		}
+
+		bar.emitted = true
+		emitted = append(emitted, builder.toBar(bar))
+	}
+
+	return emitted, nil
+}
+
+// Flush emits every bar which has not yet been emitted, in ascending order of their start times
+func (builder *BarBuilder) Flush() []*Bar {
+	return builder.emit()
+}
+
+// Helper function that returns all the bars that have not yet been emitted, sorted by start time, and
+// marks them as emitted
+func (builder *BarBuilder) emit() []*Bar {
+
+	// First, collect all the bars which have not been emitted
+	states := make([]*barState, 0)
+	for _, state := range builder.bars {
+		if !state.emitted {
+			states = append(states, state)
+		}
+	}
+
+	// Next, sort the bars by their start times
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].start.LessThan(states[j].start)
+	})
+
+	// Finally, convert the states to bars and mark them as emitted
+	bars := make([]*Bar, len(states))
+	for i, state := range states {
+		state.emitted = true
+		bars[i] = builder.toBar(state)
+	}
+
+	return bars
+}
+
+// Helper function that updates the bar starting at the timestamp provided with a trade, creating the
+// bar if it does not exist, and returns the bar. If the trade should not be included in bars, either
+// because it was cancelled or because its conditions make it ineligible, then nil will be returned
+func (builder *BarBuilder) apply(trade *Trade, start *UnixTimestamp) *barState {
+
+	// First, check that the trade should be included in bars at all; only regular trades and original
+	// trades which contain their corrected data are included
+	switch trade.Correction {
+	case Financial_Trades_NotCorrected, Financial_Trades_LateCorrected:
+	default:
+		return nil
+	}
+
+	// Next, check that the trade's conditions allow it to update at least one statistic on the bar
+	rules := CombinedTradeConditionRules(trade.Tape, trade.Conditions...).Consolidated
+	if !rules.UpdatesHighLow && !rules.UpdatesLast && !rules.UpdatesOpenClose && !rules.UpdatesVolume {
+		return nil
+	}
+
+	// Finally, retrieve the bar associated with the trade, creating it if it doesn't exist, and update
+	// it with the trade according to the rules associated with the trade's conditions
+	key := barKey{seconds: start.Seconds, nanos: start.Nanoseconds}
+	bar, ok := builder.bars[key]
+	if !ok {
+		bar = &barState{start: start}
+		builder.bars[key] = bar
+	}
+
+	bar.update(trade, rules)
+	return bar
+}
+
+// Helper function that removes any bars which started before the lateness window
+func (builder *BarBuilder) prune() {
+	cutoff := builder.cutoff()
+	for key, state := range builder.bars {
+		if state.emitted && state.start.LessThan(cutoff) {
+			delete(builder.bars, key)
+		}
+	}
+}
+
+// Helper function that returns the start of the earliest bar that can still be amended. If the builder
+// has no lateness window then nil will be returned
+func (builder *BarBuilder) cutoff() *UnixTimestamp {
+	if builder.lateness < 0 {
+		return nil
+	}
+
+	return builder.watermark.AddDuration(NewFromDuration(-builder.lateness))
+}
+
+// Helper function that updates the working state of a bar with a trade, according to the rules
+func (bar *barState) update(trade *Trade, rules TradeUpdateRules) {
+	price := trade.Price.ToDecimal()
+
+	// First, update the high and low prices if the trade is eligible to do so
+	if rules.UpdatesHighLow {
+		if !bar.hasRange || price.GreaterThan(bar.high) {
+			bar.high = *price
+		}
+
+		if !bar.hasRange || price.LessThan(bar.low) {
+			bar.low = *price
+		}
+
+		bar.hasRange = true
+	}
+
+	// Next, update the open and close prices if the trade is eligible to do so. Since trades may arrive
+	// out of order we compare the timestamp of the trade to those of the current open and close
+	if rules.UpdatesOpenClose || rules.UpdatesLast {
+		if bar.openTime == nil || trade.Timestamp.LessThan(bar.openTime) {
+			bar.open, bar.openTime = *price, trade.Timestamp
+		}
+
+		if bar.closeTime == nil || trade.Timestamp.GreaterThanOrEqualTo(bar.closeTime) {
+			bar.close, bar.closeTime = *price, trade.Timestamp
+		}
+	}
+
+	// Finally, update the volume and notional value if the trade is eligible to do so and count it
+	if rules.UpdatesVolume && trade.Size != nil {
+		size := trade.Size.ToDecimal()
+		bar.volume = bar.volume.Add(*size)
+		bar.notional = bar.notional.Add(price.Mul(*size))
+	}
+
+	bar.count++
+}
+
+// Helper function that converts the working state of a bar to a Bar
+func (builder *BarBuilder) toBar(state *barState) *Bar {
+	bar := Bar{
+		Start:      state.start.Copy(),
+		End:        state.start.AddDuration(NewFromDuration(builder.interval)),
+		Volume:     NewFromDecimal(state.volume),
+		TradeCount: state.count,
+		Revision:   state.revision,
+	}
+
+	if state.hasRange {
+		bar.High, bar.Low = NewFromDecimal(state.high), NewFromDecimal(state.low)
+	}
+
+	if state.openTime != nil {
+		bar.Open, bar.Close = NewFromDecimal(state.open), NewFromDecimal(state.close)
+	}
+
+	if !state.volume.IsZero() {
+		bar.VWAP = NewFromDecimal(state.notional.DivRound(state.volume, builder.Precision))
+	}
+
+	return &bar
+}
@@ -0,0 +1,173 @@
+package gopb
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Bar Aggregation Tests", func() {
+
+	// Helper function that verifies the fields on a bar
+	verifyBar := func(bar *Bar, start int64, open string, high string, low string, close string,
+		volume string, vwap string, count int64, revision int) {
+		Expect(bar.Start).Should(Equal(NewUnixTimestamp(start, 0)))
+		Expect(bar.End).Should(Equal(NewUnixTimestamp(start+60, 0)))
+		Expect(bar.Open.ToString()).Should(Equal(open))
+		Expect(bar.High.ToString()).Should(Equal(high))
+		Expect(bar.Low.ToString()).Should(Equal(low))
+		Expect(bar.Close.ToString()).Should(Equal(close))
+		Expect(bar.Volume.ToString()).Should(Equal(volume))
+		Expect(bar.VWAP.ToString()).Should(Equal(vwap))
+		Expect(bar.TradeCount).Should(Equal(count))
+		Expect(bar.Revision).Should(Equal(revision))
+	}
+
+	// Tests that the Align function snaps timestamps to the start of their bars
+	DescribeTable("Align - Works",
+		func(interval time.Duration, timestamp *UnixTimestamp, expected *UnixTimestamp) {
+			builder, err := NewBarBuilder(interval, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(builder.Align(timestamp)).Should(Equal(expected))
+		},
+		Entry("Second - Aligned", time.Second, NewUnixTimestamp(base+13, 500), NewUnixTimestamp(base+13, 0)),
+		Entry("Sub-second - Aligned", 1500*time.Millisecond, NewUnixTimestamp(base+2, 700000000),
+			NewUnixTimestamp(base+1, 500000000)),
+		Entry("Five minutes - Aligned", 5*time.Minute, NewUnixTimestamp(base+433, 1), NewUnixTimestamp(base+300, 0)),
+		Entry("Hour - Aligned", time.Hour, NewUnixTimestamp(base+433, 1), NewUnixTimestamp(base-1800, 0)),
+		Entry("Day - Aligned", 24*time.Hour, NewUnixTimestamp(base+433, 1), NewUnixTimestamp(1654041600, 0)),
+		Entry("Minute, before epoch - Aligned", time.Minute, NewUnixTimestamp(-61, 500), NewUnixTimestamp(-120, 0)),
+		Entry("Sub-second, before epoch - Aligned", 1500*time.Millisecond, NewUnixTimestamp(-1, 0),
+			NewUnixTimestamp(-2, 500000000)),
+		Entry("Day, before epoch - Aligned", 24*time.Hour, NewUnixTimestamp(-3600, 0), NewUnixTimestamp(-86400, 0)),
+		Entry("Minute, after 2262 - Aligned", time.Minute, NewUnixTimestamp(32503680125, 500),
+			NewUnixTimestamp(32503680120, 0)),
+		Entry("Sub-second, after 2262 - Aligned", 1500*time.Millisecond, NewUnixTimestamp(32503680007, 300000000),
+			NewUnixTimestamp(32503680006, 0)),
+		Entry("Seven hours, after 2262 - Aligned", 7*time.Hour, NewUnixTimestamp(32503725000, 0),
+			NewUnixTimestamp(32503716000, 0)))
+
+	// Tests that the NewBarBuilder function returns an error, and the AggregateBars function returns no bars,
+	// if the interval is not positive
+	DescribeTable("NewBarBuilder, AggregateBars - Interval not positive - Error",
+		func(interval time.Duration, message string) {
+			builder, err := NewBarBuilder(interval, 0)
+			Expect(builder).Should(BeNil())
+			Expect(errors.Is(err, utils.ErrUnderflow)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal(message))
+
+			Expect(AggregateBars(interval, []*Trade{trade(5*time.Second, "10", "100")})).Should(BeNil())
+		},
+		Entry("Zero - Error", time.Duration(0), "bar interval (0s) is less than the minimum of 1ns"),
+		Entry("Negative - Error", -time.Minute, "bar interval (-1m0s) is less than the minimum of 1ns"))
+
+	// Tests that the Add function emits bars as they are completed and amends them for late trades
+	It("Add - Works", func() {
+		builder, err := NewBarBuilder(time.Minute, 5*time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// First, add trades for the first bar; no bars should be emitted
+		for _, t := range []*Trade{
+			trade(5*time.Second, "10", "100"),
+			trade(20*time.Second, "11", "50"),
+			trade(30*time.Second, "9.5", "200", Financial_Trades_OddLotTrade),
+		} {
+			bars, err := builder.Add(t)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bars).Should(BeEmpty())
+		}
+
+		// Next, add a trade for the second bar; this should emit the first bar
+		bars, err := builder.Add(trade(65*time.Second, "10.5", "100"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bars).Should(HaveLen(1))
+		verifyBar(bars[0], base, "10", "11", "10", "11", "350", "9.8571428571", 3, 0)
+
+		// Now, add a late, out-of-sequence trade for the first bar; this should amend the first bar
+		// without changing its open or close
+		bars, err = builder.Add(trade(10*time.Second, "12", "10", Financial_Trades_SoldOutOfSequence))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bars).Should(HaveLen(1))
+		verifyBar(bars[0], base, "10", "12", "10", "11", "360", "9.9166666667", 4, 1)
+
+		// Add a late, regular trade for the first bar; this should amend the first bar's open
+		bars, err = builder.Add(trade(2*time.Second, "9", "10"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bars).Should(HaveLen(1))
+		verifyBar(bars[0], base, "9", "12", "9", "11", "370", "9.8918918919", 5, 2)
+
+		// Add a cancelled trade for the first bar; this should be ignored
+		cancelled := trade(15*time.Second, "100", "1000")
+		cancelled.Correction = Financial_Trades_Cancel
+		bars, err = builder.Add(cancelled)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bars).Should(BeEmpty())
+
+		// Finally, flush the builder; this should emit the second bar
+		bars = builder.Flush()
+		Expect(bars).Should(HaveLen(1))
+		verifyBar(bars[0], base+60, "10.5", "10.5", "10.5", "10.5", "100", "10.5", 1, 0)
+		Expect(builder.Flush()).Should(BeEmpty())
+	})
+
+	// Tests that the Add function emits a bar for a late trade that falls into an empty interval
+	It("Add - Late trade into empty bar - Emitted", func() {
+		builder, err := NewBarBuilder(time.Minute, 5*time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// First, add trades for the first and third bars; the first bar should be emitted
+		_, err = builder.Add(trade(5*time.Second, "10", "100"))
+		Expect(err).ShouldNot(HaveOccurred())
+		bars, err := builder.Add(trade(125*time.Second, "11", "100"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bars).Should(HaveLen(1))
+
+		// Next, add a late trade for the second bar; this should emit the second bar immediately
+		bars, err = builder.Add(trade(70*time.Second, "10.5", "20", Financial_Trades_Late))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bars).Should(HaveLen(1))
+		Expect(bars[0].Start).Should(Equal(NewUnixTimestamp(base+60, 0)))
+		Expect(bars[0].Open).Should(BeNil())
+		Expect(bars[0].Close).Should(BeNil())
+		Expect(bars[0].High.ToString()).Should(Equal("10.5"))
+		Expect(bars[0].Low.ToString()).Should(Equal("10.5"))
+		Expect(bars[0].Volume.ToString()).Should(Equal("20"))
+		Expect(bars[0].Revision).Should(BeZero())
+	})
+
+	// Tests that the Add function returns an error if a trade falls outside the lateness window
+	It("Add - Trade too late - Error", func() {
+		builder, err := NewBarBuilder(time.Minute, time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// First, add a trade a few bars after the first bar
+		_, err = builder.Add(trade(185*time.Second, "10", "100"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Next, attempt to add a trade to the first bar; this should fail
+		bars, err := builder.Add(trade(30*time.Second, "10", "100"))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("trade at 1654093830000000000 is too late to be " +
+			"aggregated into a bar starting at 1654093800000000000"))
+		Expect(bars).Should(BeNil())
+	})
+
+	// Tests that the AggregateBars function aggregates trades regardless of their order
+	It("AggregateBars - Works", func() {
+		bars := AggregateBars(time.Minute, []*Trade{
+			trade(65*time.Second, "10.5", "100"),
+			trade(20*time.Second, "11", "50"),
+			trade(5*time.Second, "10", "100"),
+			trade(30*time.Second, "9.5", "200", Financial_Trades_OddLotTrade),
+			trade(10*time.Second, "12", "10", Financial_Trades_SoldOutOfSequence),
+			trade(2*time.Second, "9", "10"),
+		})
+
+		Expect(bars).Should(HaveLen(2))
+		verifyBar(bars[0], base, "9", "12", "9", "11", "370", "9.8918918919", 5, 0)
+		verifyBar(bars[1], base+60, "10.5", "10.5", "10.5", "10.5", "100", "10.5", 1, 0)
+	})
+})
@@ -0,0 +1,160 @@
+package gopb
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Tick snapshot, as it would be stored in MongoDB, containing each of the types that can be written to BSON
+type bsonSnapshot struct {
+	Price     *Decimal                    `bson:"price"`
+	Timestamp *UnixTimestamp              `bson:"timestamp"`
+	Class     Financial_Common_AssetClass `bson:"class"`
+	Condition Financial_Trades_Condition  `bson:"condition"`
+	Missing   *Decimal                    `bson:"missing"`
+}
+
+var _ = Describe("BSON Tests", func() {
+
+	// Helper function that returns the BSON type of a field in a document
+	fieldType := func(document []byte, field string) bsontype.Type {
+		return bson.Raw(document).Lookup(field).Type
+	}
+
+	// Tests that a snapshot can be written to BSON, using the native BSON types, and read back again
+	It("Marshal, Unmarshal - Works", func() {
+		snapshot := bsonSnapshot{
+			Price:     &Decimal{Parts: []int64{-12345}, Exp: -3},
+			Timestamp: NewUnixTimestamp(1654127993, 983000000),
+			Class:     Financial_Common_Crypto,
+			Condition: Financial_Trades_CashSale,
+		}
+
+		data, err := bson.Marshal(snapshot)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(fieldType(data, "price")).Should(Equal(bsontype.Decimal128))
+		Expect(fieldType(data, "timestamp")).Should(Equal(bsontype.DateTime))
+		Expect(fieldType(data, "class")).Should(Equal(bsontype.String))
+		Expect(bson.Raw(data).Lookup("condition").StringValue()).Should(Equal("Cash Sale"))
+		Expect(fieldType(data, "missing")).Should(Equal(bsontype.Null))
+
+		var parsed bsonSnapshot
+		Expect(bson.Unmarshal(data, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Price.ToString()).Should(Equal("-12.345"))
+		Expect(parsed.Timestamp).Should(Equal(snapshot.Timestamp))
+		Expect(parsed.Class).Should(Equal(snapshot.Class))
+		Expect(parsed.Condition).Should(Equal(snapshot.Condition))
+		Expect(parsed.Missing).Should(BeNil())
+	})
+
+	// Tests that a decimal which cannot be represented exactly as a Decimal128 is written as a string
+	It("Decimal - Too many digits - String", func() {
+		snapshot := bsonSnapshot{Price: &Decimal{Parts: []int64{234109887750000111, 6554423234109887750}, Exp: -3}}
+		data, err := bson.Marshal(snapshot)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bson.Raw(data).Lookup("price").StringValue()).Should(Equal(snapshot.Price.ToString()))
+
+		var parsed bsonSnapshot
+		Expect(bson.Unmarshal(data, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Price.ToString()).Should(Equal(snapshot.Price.ToString()))
+	})
+
+	// Tests that a decimal can be read from a BSON number
+	It("Decimal - Numbers - Works", func() {
+		var parsed bsonSnapshot
+		Expect(bson.Unmarshal(mustMarshalBSON(bson.M{"price": 1.5}), &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Price.ToString()).Should(Equal("1.5"))
+
+		Expect(bson.Unmarshal(mustMarshalBSON(bson.M{"price": int32(-7)}), &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Price.ToString()).Should(Equal("-7"))
+	})
+
+	// Tests that writing a timestamp with sub-millisecond precision as a datetime returns an error, and
+	// that it can be written as a document instead
+	It("Timestamp - Nanoseconds - Precision loss or document", func() {
+		snapshot := bsonSnapshot{Timestamp: NewUnixTimestamp(1654127993, 983651350)}
+		_, err := bson.Marshal(snapshot)
+		Expect(errors.Is(err, utils.ErrPrecisionLoss)).Should(BeTrue())
+
+		SetTimestampBSONFormat(BSONDocument)
+		DeferCleanup(SetTimestampBSONFormat, BSONDateTime)
+
+		data, err := bson.Marshal(snapshot)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(fieldType(data, "timestamp")).Should(Equal(bsontype.EmbeddedDocument))
+		Expect(bson.Raw(data).Lookup("timestamp", "nanoseconds").Int32()).Should(Equal(int32(983651350)))
+
+		var parsed bsonSnapshot
+		Expect(bson.Unmarshal(data, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Timestamp).Should(Equal(snapshot.Timestamp))
+	})
+
+	// Tests that a timestamp can be read from an RFC 3339 string or a UNIX epoch value
+	It("Timestamp - Alternate forms - Works", func() {
+		expected := NewUnixTimestamp(1654127993, 983651350)
+
+		var parsed bsonSnapshot
+		document := mustMarshalBSON(bson.M{"timestamp": "2022-06-01T23:59:53.98365135Z"})
+		Expect(bson.Unmarshal(document, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Timestamp).Should(Equal(expected))
+
+		document = mustMarshalBSON(bson.M{"timestamp": int64(1654127993983651350)})
+		Expect(bson.Unmarshal(document, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Timestamp).Should(Equal(expected))
+	})
+
+	// Tests that enums can be written as integers, either globally or for a single codec, and that they can
+	// be read back from either format
+	It("Enums - Integer format - Works", func() {
+		utils.SetEnumBSONFormat(utils.EnumBSONInt)
+		DeferCleanup(utils.SetEnumBSONFormat, utils.DefaultEnumBSON)
+
+		snapshot := bsonSnapshot{Class: Financial_Common_Crypto, Condition: Financial_Trades_CashSale}
+		data, err := bson.Marshal(snapshot)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bson.Raw(data).Lookup("class").Int32()).Should(Equal(int32(2)))
+		Expect(bson.Raw(data).Lookup("condition").Int32()).Should(Equal(int32(7)))
+
+		var parsed bsonSnapshot
+		Expect(bson.Unmarshal(data, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Class).Should(Equal(snapshot.Class))
+		Expect(parsed.Condition).Should(Equal(snapshot.Condition))
+
+		document := mustMarshalBSON(bson.M{"class": "Crypto", "condition": int64(7)})
+		Expect(bson.Unmarshal(document, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Class).Should(Equal(snapshot.Class))
+		Expect(parsed.Condition).Should(Equal(snapshot.Condition))
+	})
+
+	// Tests that values with an unsupported BSON type cannot be read
+	It("Unmarshal - Unsupported type - TypeError", func() {
+		for _, field := range []string{"price", "timestamp", "class"} {
+			var parsed bsonSnapshot
+			err := bson.Unmarshal(mustMarshalBSON(bson.M{field: true}), &parsed)
+
+			var typeErr *utils.TypeError
+			Expect(errors.As(err, &typeErr)).Should(BeTrue())
+			Expect(typeErr.Source).Should(Equal("BSON value"))
+			Expect(typeErr.Actual).Should(Equal("boolean"))
+		}
+	})
+
+	// Tests that reading an unknown enum name returns an EnumError
+	It("Unmarshal - Unknown enum name - EnumError", func() {
+		var parsed bsonSnapshot
+		err := bson.Unmarshal(mustMarshalBSON(bson.M{"class": "derp"}), &parsed)
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+	})
+})
+
+// Helper function that converts a document to BSON, failing the test if this isn't possible
+func mustMarshalBSON(document interface{}) []byte {
+	data, err := bson.Marshal(document)
+	Expect(err).ShouldNot(HaveOccurred())
+	return data
+}
@@ -0,0 +1,420 @@
+package gopb
+
+// TradeUpdateRules describes which aggregate statistics a trade should be allowed to update
+type TradeUpdateRules struct {
+	UpdatesHighLow   bool
+	UpdatesLast      bool
+	UpdatesOpenClose bool
+	UpdatesVolume    bool
+}
+
+// TradeConditionRule describes which aggregate statistics a trade should be allowed to update, both for
+// the consolidated statistics of a security and for those of the market center that reported the trade
+type TradeConditionRule struct {
+	Consolidated TradeUpdateRules
+	MarketCenter TradeUpdateRules
+}
+
+// Common update rules, used to build the trade condition matrix
+var (
+	updatesAll           = TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true}
+	updatesHighLowVolume = TradeUpdateRules{UpdatesHighLow: true, UpdatesVolume: true}
+	updatesVolume        = TradeUpdateRules{UpdatesVolume: true}
+	updatesNothing       = TradeUpdateRules{}
+)
+
+// Common trade condition rules, used to build the trade condition matrix
+var (
+	eligibleTrade       = TradeConditionRule{Consolidated: updatesAll, MarketCenter: updatesAll}
+	outOfSequenceTrade  = TradeConditionRule{Consolidated: updatesHighLowVolume, MarketCenter: updatesHighLowVolume}
+	volumeOnlyTrade     = TradeConditionRule{Consolidated: updatesVolume, MarketCenter: updatesVolume}
+	nonEligibleTrade    = TradeConditionRule{Consolidated: updatesNothing, MarketCenter: updatesNothing}
+	informationalRecord = eligibleTrade
+)
+
+// tradeConditionMatrix contains the update rules associated with each Financial.Trades.Condition, as
+// defined by the CTA and UTP sale condition matrices and the OPRA trade message types. Conditions that
+// describe the status of a security rather than the trade itself do not restrict any updates so that
+// they have no effect when combined with other conditions. Note that the plans allow some conditions
+// that don't update the last price (such as Sold Out of Sequence) to do so when the trade is the only
+// trade of the day; as this requires knowledge of the session, it is left to the caller
+var tradeConditionMatrix = map[Financial_Trades_Condition]TradeConditionRule{
+	Financial_Trades_RegularSale:                 eligibleTrade,
+	Financial_Trades_Acquisition:                 eligibleTrade,
+	Financial_Trades_AveragePriceTrade:           volumeOnlyTrade,
+	Financial_Trades_AutomaticExecution:          eligibleTrade,
+	Financial_Trades_BunchedTrade:                eligibleTrade,
+	Financial_Trades_BunchedSoldTrade:            outOfSequenceTrade,
+	Financial_Trades_CAPElection:                 eligibleTrade,
+	Financial_Trades_CashSale:                    volumeOnlyTrade,
+	Financial_Trades_ClosingPrints:               eligibleTrade,
+	Financial_Trades_CrossTrade:                  eligibleTrade,
+	Financial_Trades_DerivativelyPriced:          outOfSequenceTrade,
+	Financial_Trades_Distribution:                eligibleTrade,
+	Financial_Trades_FormT:                       volumeOnlyTrade,
+	Financial_Trades_ExtendedTradingHours:        volumeOnlyTrade,
+	Financial_Trades_IntermarketSweep:            eligibleTrade,
+	Financial_Trades_MarketCenterOpeningTrade:    eligibleTrade,
+	Financial_Trades_MarketCenterReopeningTrade:  eligibleTrade,
+	Financial_Trades_MarketCenterClosingTrade:    eligibleTrade,
+	Financial_Trades_NextDay:                     volumeOnlyTrade,
+	Financial_Trades_PriceVariationTrade:         volumeOnlyTrade,
+	Financial_Trades_PriorReferencePrice:         outOfSequenceTrade,
+	Financial_Trades_Rule155Trade:                eligibleTrade,
+	Financial_Trades_Rule127NYSE:                 eligibleTrade,
+	Financial_Trades_OpeningPrints:               eligibleTrade,
+	Financial_Trades_Opened:                      eligibleTrade,
+	Financial_Trades_StoppedStock:                eligibleTrade,
+	Financial_Trades_ReOpeningPrints:             eligibleTrade,
+	Financial_Trades_Seller:                      volumeOnlyTrade,
+	Financial_Trades_SoldLast:                    eligibleTrade,
+	Financial_Trades_SoldLastAndStoppedStock:     eligibleTrade,
+	Financial_Trades_SoldOut:                     outOfSequenceTrade,
+	Financial_Trades_SoldOutOfSequence:           outOfSequenceTrade,
+	Financial_Trades_SplitTrade:                  eligibleTrade,
+	Financial_Trades_StockOption:                 eligibleTrade,
+	Financial_Trades_YellowFlagRegularTrade:      eligibleTrade,
+	Financial_Trades_OddLotTrade:                 volumeOnlyTrade,
+	Financial_Trades_Unknown:                     volumeOnlyTrade,
+	Financial_Trades_Held:                        volumeOnlyTrade,
+	Financial_Trades_TradeThruExempt:             eligibleTrade,
+	Financial_Trades_NonEligible:                 volumeOnlyTrade,
+	Financial_Trades_NonEligibleExtended:         volumeOnlyTrade,
+	Financial_Trades_Cancelled:                   nonEligibleTrade,
+	Financial_Trades_Recovery:                    eligibleTrade,
+	Financial_Trades_Correction:                  outOfSequenceTrade,
+	Financial_Trades_AsOf:                        outOfSequenceTrade,
+	Financial_Trades_AsOfCorrection:              outOfSequenceTrade,
+	Financial_Trades_AsOfCancel:                  nonEligibleTrade,
+	Financial_Trades_OOB:                         volumeOnlyTrade,
+	Financial_Trades_Summary:                     nonEligibleTrade,
+	Financial_Trades_ContingentTrade:             volumeOnlyTrade,
+	Financial_Trades_QualifiedContingentTrade:    volumeOnlyTrade,
+	Financial_Trades_Errored:                     nonEligibleTrade,
+	Financial_Trades_OpeningReopeningTradeDetail: nonEligibleTrade,
+	Financial_Trades_Placeholder:                 nonEligibleTrade,
+	Financial_Trades_MarketCenterOfficialClose: {
+		Consolidated: updatesNothing,
+		MarketCenter: TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true},
+	},
+	Financial_Trades_MarketCenterOfficialOpen: {
+		Consolidated: updatesNothing,
+		MarketCenter: TradeUpdateRules{UpdatesHighLow: true, UpdatesOpenClose: true},
+	},
+	Financial_Trades_CorrectedConsolidatedClose: {
+		Consolidated: TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true},
+		MarketCenter: updatesNothing,
+	},
+	Financial_Trades_ShortSaleRestrictionActivated:                     informationalRecord,
+	Financial_Trades_ShortSaleRestrictionContinued:                     informationalRecord,
+	Financial_Trades_ShortSaleRestrictionDeactivated:                   informationalRecord,
+	Financial_Trades_ShortSaleRestrictionInEffect:                      informationalRecord,
+	Financial_Trades_FinancialStatusBankrupt:                           informationalRecord,
+	Financial_Trades_FinancialStatusDeficient:                          informationalRecord,
+	Financial_Trades_FinancialStatusDelinquent:                         informationalRecord,
+	Financial_Trades_FinancialStatusBankruptAndDeficient:               informationalRecord,
+	Financial_Trades_FinancialStatusBankruptAndDelinquent:              informationalRecord,
+	Financial_Trades_FinancialStatusDeficientAndDelinquent:             informationalRecord,
+	Financial_Trades_FinancialStatusDeficientDelinquentBankrupt:        informationalRecord,
+	Financial_Trades_FinancialStatusLiquidation:                        informationalRecord,
+	Financial_Trades_FinancialStatusCreationsSuspended:                 informationalRecord,
+	Financial_Trades_FinancialStatusRedemptionsSuspended:               informationalRecord,
+	Financial_Trades_Canceled:                                          nonEligibleTrade,
+	Financial_Trades_LateAndOutOfSequence:                              outOfSequenceTrade,
+	Financial_Trades_LastAndCanceled:                                   nonEligibleTrade,
+	Financial_Trades_Late:                                              outOfSequenceTrade,
+	Financial_Trades_OpeningTradeAndCanceled:                           nonEligibleTrade,
+	Financial_Trades_OpeningTradeLateAndOutOfSequence:                  outOfSequenceTrade,
+	Financial_Trades_OnlyTradeAndCanceled:                              nonEligibleTrade,
+	Financial_Trades_OpeningTradeAndLate:                               outOfSequenceTrade,
+	Financial_Trades_AutomaticExecutionOption:                          eligibleTrade,
+	Financial_Trades_ReopeningTrade:                                    eligibleTrade,
+	Financial_Trades_IntermarketSweepOrder:                             eligibleTrade,
+	Financial_Trades_SingleLegAuctionNonISO:                            eligibleTrade,
+	Financial_Trades_SingleLegAuctionISO:                               eligibleTrade,
+	Financial_Trades_SingleLegCrossNonISO:                              eligibleTrade,
+	Financial_Trades_SingleLegCrossISO:                                 eligibleTrade,
+	Financial_Trades_SingleLegFloorTrade:                               eligibleTrade,
+	Financial_Trades_MultiLegAutoElectronicTrade:                       volumeOnlyTrade,
+	Financial_Trades_MultiLegAuction:                                   volumeOnlyTrade,
+	Financial_Trades_MultiLegCross:                                     volumeOnlyTrade,
+	Financial_Trades_MultiLegFloorTrade:                                volumeOnlyTrade,
+	Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg:       volumeOnlyTrade,
+	Financial_Trades_StockOptionsAuction:                               volumeOnlyTrade,
+	Financial_Trades_MultiLegAuctionAgainstSingleLeg:                   volumeOnlyTrade,
+	Financial_Trades_MultiLegFloorTradeAgainstSingleLeg:                volumeOnlyTrade,
+	Financial_Trades_StockOptionsAutoElectronicTrade:                   volumeOnlyTrade,
+	Financial_Trades_StockOptionsCross:                                 volumeOnlyTrade,
+	Financial_Trades_StockOptionsFloorTrade:                            volumeOnlyTrade,
+	Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg:   volumeOnlyTrade,
+	Financial_Trades_StockOptionsAuctionAgainstSingleLeg:               volumeOnlyTrade,
+	Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg:            volumeOnlyTrade,
+	Financial_Trades_MultiLegFloorTradeOfProprietaryProducts:           volumeOnlyTrade,
+	Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts: volumeOnlyTrade,
+	Financial_Trades_ExtendedHoursTrade:                                volumeOnlyTrade,
+}
+
+// tradeConditionPlans contains the tapes on which each plan-specific Financial.Trades.Condition is
+// defined. Conditions that aren't included here are defined on every tape. A condition reported on a
+// tape whose plan does not define it cannot be interpreted and so is treated as a volume-only trade
+var tradeConditionPlans = map[Financial_Trades_Condition][]Financial_Common_Tape{
+	Financial_Trades_AutomaticExecution:         {Financial_Common_A, Financial_Common_B},
+	Financial_Trades_Rule127NYSE:                {Financial_Common_A, Financial_Common_B},
+	Financial_Trades_MarketCenterOpeningTrade:   {Financial_Common_A, Financial_Common_B},
+	Financial_Trades_MarketCenterReopeningTrade: {Financial_Common_A, Financial_Common_B},
+	Financial_Trades_MarketCenterClosingTrade:   {Financial_Common_A, Financial_Common_B},
+	Financial_Trades_Acquisition:                {Financial_Common_C},
+	Financial_Trades_BunchedTrade:               {Financial_Common_C},
+	Financial_Trades_BunchedSoldTrade:           {Financial_Common_C},
+	Financial_Trades_Distribution:               {Financial_Common_C},
+	Financial_Trades_SplitTrade:                 {Financial_Common_C},
+	Financial_Trades_YellowFlagRegularTrade:     {Financial_Common_C},
+	Financial_Trades_FormT:                      {Financial_Common_C},
+	Financial_Trades_OpeningPrints:              {Financial_Common_C},
+	Financial_Trades_ReOpeningPrints:            {Financial_Common_C},
+	Financial_Trades_ClosingPrints:              {Financial_Common_C},
+}
+
+// LookupTradeConditionRule returns the update rules defined for a Financial.Trades.Condition in the
+// condition matrix and whether or not the condition was found. Unlike TradeConditionRules, this does
+// not take the plan associated with the tape into account
+func LookupTradeConditionRule(cond Financial_Trades_Condition) (TradeConditionRule, bool) {
+	rule, ok := tradeConditionMatrix[cond]
+	return rule, ok
+}
+
+// LookupTradeConditionTapes returns the tapes on which a plan-specific Financial.Trades.Condition is
+// defined and whether or not the condition is plan-specific. The returned slice is a copy and so may
+// be modified by the caller
+func LookupTradeConditionTapes(cond Financial_Trades_Condition) ([]Financial_Common_Tape, bool) {
+	tapes, ok := tradeConditionPlans[cond]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]Financial_Common_Tape(nil), tapes...), true
+}
+
+// And returns update rules that allow an update only if both sets of update rules allow it
+func (rules TradeUpdateRules) And(other TradeUpdateRules) TradeUpdateRules {
+	return TradeUpdateRules{
+		UpdatesHighLow:   rules.UpdatesHighLow && other.UpdatesHighLow,
+		UpdatesLast:      rules.UpdatesLast && other.UpdatesLast,
+		UpdatesOpenClose: rules.UpdatesOpenClose && other.UpdatesOpenClose,
+		UpdatesVolume:    rules.UpdatesVolume && other.UpdatesVolume,
+	}
+}
+
+// Combine returns the trade condition rule that results from a trade having both conditions. As with
+// the CTA and UTP plans, the most restrictive rule applies to each statistic
+func (rule TradeConditionRule) Combine(other TradeConditionRule) TradeConditionRule {
+	return TradeConditionRule{
+		Consolidated: rule.Consolidated.And(other.Consolidated),
+		MarketCenter: rule.MarketCenter.And(other.MarketCenter),
+	}
+}
+
+// TradeConditionRules returns the update rules associated with a single Financial.Trades.Condition
+// reported on the tape provided. Conditions which are not included in the condition matrix, or which
+// are not defined by the plan associated with the tape, will be treated as volume-only trades
+func TradeConditionRules(cond Financial_Trades_Condition, tape Financial_Common_Tape) TradeConditionRule {
+
+	// First, check if the condition is specific to a plan; if it is and the tape isn't covered by that
+	// plan then we don't know how to interpret it so only allow it to update volume
+	if tapes, ok := tradeConditionPlans[cond]; ok && !containsTape(tapes, tape) {
+		return volumeOnlyTrade
+	}
+
+	// Next, attempt to retrieve the rule from the matrix; if it's not there then only allow the trade
+	// to update volume. Otherwise, return the rule
+	if rule, ok := tradeConditionMatrix[cond]; ok {
+		return rule
+	}
+
+	return volumeOnlyTrade
+}
+
+// CombinedTradeConditionRules returns the update rules associated with a trade reported on the tape
+// provided that has all the conditions provided. A trade with no conditions is a regular sale
+func CombinedTradeConditionRules(tape Financial_Common_Tape, conds ...Financial_Trades_Condition) TradeConditionRule {
+	rule := eligibleTrade
+	for _, cond := range conds {
+		rule = rule.Combine(TradeConditionRules(cond, tape))
+	}
+
+	return rule
+}
+
+// QuoteConditionRule describes how a quote with a particular condition should be interpreted. The bid
+// and ask eligibility determine whether each side of the quote may be included in the NBBO. A quote
+// that is not firm is indicative. The remaining fields describe the state of trading that the condition
+// signals on the venue that reported the quote
+type QuoteConditionRule struct {
+	BidEligible bool
+	AskEligible bool
+	Firm        bool
+	Opening     bool
+	Closing     bool
+	Halted      bool
+}
+
+// Common quote condition rules, used to build the quote condition table
+var (
+	eligibleQuote      = QuoteConditionRule{BidEligible: true, AskEligible: true, Firm: true}
+	openingQuote       = QuoteConditionRule{BidEligible: true, AskEligible: true, Firm: true, Opening: true}
+	closingQuote       = QuoteConditionRule{BidEligible: true, AskEligible: true, Firm: true, Closing: true}
+	bidOnlyQuote       = QuoteConditionRule{BidEligible: true, Firm: true}
+	askOnlyQuote       = QuoteConditionRule{AskEligible: true, Firm: true}
+	nonEligibleQuote   = QuoteConditionRule{Firm: true}
+	indicativeQuote    = QuoteConditionRule{}
+	preOpeningQuote    = QuoteConditionRule{Opening: true}
+	closedQuote        = QuoteConditionRule{Closing: true}
+	haltedQuote        = QuoteConditionRule{Halted: true}
+	informationalQuote = eligibleQuote
+)
+
+// quoteConditionTable contains the rules associated with each Financial.Quotes.Condition, as defined by
+// the CQS and UTP quote condition specifications. Slow and manual quotes remain firm but the affected
+// sides are not protected and so are not eligible for the NBBO. Conditions that describe the state of
+// the market rather than the quote itself, such as Locked Market, are treated as informational
+var quoteConditionTable = map[Financial_Quotes_Condition]QuoteConditionRule{
+	Financial_Quotes_Regular:                                   eligibleQuote,
+	Financial_Quotes_RegularTwoSidedOpen:                       openingQuote,
+	Financial_Quotes_RegularOneSidedOpen:                       openingQuote,
+	Financial_Quotes_SlowAsk:                                   bidOnlyQuote,
+	Financial_Quotes_SlowBid:                                   askOnlyQuote,
+	Financial_Quotes_SlowBidAsk:                                nonEligibleQuote,
+	Financial_Quotes_SlowDueLRPBid:                             askOnlyQuote,
+	Financial_Quotes_SlowDueLRPAsk:                             bidOnlyQuote,
+	Financial_Quotes_SlowDueNYSELRP:                            nonEligibleQuote,
+	Financial_Quotes_SlowDueSetSlowListBidAsk:                  nonEligibleQuote,
+	Financial_Quotes_ManualAskAutomatedBid:                     bidOnlyQuote,
+	Financial_Quotes_ManualBidAutomatedAsk:                     askOnlyQuote,
+	Financial_Quotes_ManualBidAndAsk:                           nonEligibleQuote,
+	Financial_Quotes_Opening:                                   openingQuote,
+	Financial_Quotes_Closing:                                   closingQuote,
+	Financial_Quotes_Closed:                                    closedQuote,
+	Financial_Quotes_Resume:                                    openingQuote,
+	Financial_Quotes_FastTrading:                               indicativeQuote,
+	Financial_Quotes_TradingRangeIndicated:                     haltedQuote,
+	Financial_Quotes_MarketMakerQuotesClosed:                   closedQuote,
+	Financial_Quotes_NonFirm:                                   indicativeQuote,
+	Financial_Quotes_NewsDissemination:                         haltedQuote,
+	Financial_Quotes_OrderInflux:                               haltedQuote,
+	Financial_Quotes_OrderImbalance:                            haltedQuote,
+	Financial_Quotes_DueToRelatedSecurityNewsDissemination:     haltedQuote,
+	Financial_Quotes_DueToRelatedSecurityNewsPending:           haltedQuote,
+	Financial_Quotes_AdditionalInformation:                     haltedQuote,
+	Financial_Quotes_NewsPending:                               haltedQuote,
+	Financial_Quotes_AdditionalInformationDueToRelatedSecurity: haltedQuote,
+	Financial_Quotes_DueToRelatedSecurity:                      haltedQuote,
+	Financial_Quotes_InViewOfCommon:                            haltedQuote,
+	Financial_Quotes_EquipmentChangeover:                       haltedQuote,
+	Financial_Quotes_NoOpenNoResponse:                          haltedQuote,
+	Financial_Quotes_SubPennyTrading:                           haltedQuote,
+	Financial_Quotes_AutomatedBidNoOfferNoBid:                  eligibleQuote,
+	Financial_Quotes_LULDPriceBand:                             informationalQuote,
+	Financial_Quotes_MarketWideCircuitBreakerLevel1:            haltedQuote,
+	Financial_Quotes_MarketWideCircuitBreakerLevel2:            haltedQuote,
+	Financial_Quotes_MarketWideCircuitBreakerLevel3:            haltedQuote,
+	Financial_Quotes_RepublishedLULDPriceBand:                  informationalQuote,
+	Financial_Quotes_OnDemandAuction:                           haltedQuote,
+	Financial_Quotes_CashOnlySettlement:                        nonEligibleQuote,
+	Financial_Quotes_NextDaySettlement:                         nonEligibleQuote,
+	Financial_Quotes_LULDTradingPause:                          haltedQuote,
+	Financial_Quotes_SlowDueLRPBidAsk:                          nonEligibleQuote,
+	Financial_Quotes_Cancel:                                    indicativeQuote,
+	Financial_Quotes_CorrectedPrice:                            eligibleQuote,
+	Financial_Quotes_SIPGenerated:                              informationalQuote,
+	Financial_Quotes_Unknown:                                   indicativeQuote,
+	Financial_Quotes_CrossedMarket:                             informationalQuote,
+	Financial_Quotes_LockedMarket:                              informationalQuote,
+	Financial_Quotes_DepthOnOfferSide:                          eligibleQuote,
+	Financial_Quotes_DepthOnBidSide:                            eligibleQuote,
+	Financial_Quotes_DepthOnBidAndOffer:                        eligibleQuote,
+	Financial_Quotes_PreOpeningIndication:                      preOpeningQuote,
+	Financial_Quotes_SyndicateBid:                              nonEligibleQuote,
+	Financial_Quotes_PreSyndicateBid:                           nonEligibleQuote,
+	Financial_Quotes_PenaltyBid:                                nonEligibleQuote,
+	Financial_Quotes_CQSGenerated:                              informationalQuote,
+	Financial_Quotes_Invalid:                                   indicativeQuote,
+}
+
+// quoteConditionPlans contains the tapes on which each plan-specific Financial.Quotes.Condition is
+// defined. Conditions that aren't included here are defined on every tape. A condition reported on a
+// tape whose plan does not define it cannot be interpreted and so is treated as an indicative quote
+var quoteConditionPlans = map[Financial_Quotes_Condition][]Financial_Common_Tape{
+	Financial_Quotes_SlowDueLRPBid:                             {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_SlowDueLRPAsk:                             {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_SlowDueNYSELRP:                            {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_SlowDueSetSlowListBidAsk:                  {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_SlowDueLRPBidAsk:                          {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_OrderInflux:                               {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_DueToRelatedSecurityNewsDissemination:     {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_DueToRelatedSecurityNewsPending:           {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_AdditionalInformationDueToRelatedSecurity: {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_DueToRelatedSecurity:                      {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_InViewOfCommon:                            {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_EquipmentChangeover:                       {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_CQSGenerated:                              {Financial_Common_A, Financial_Common_B},
+	Financial_Quotes_ManualAskAutomatedBid:                     {Financial_Common_C},
+	Financial_Quotes_ManualBidAutomatedAsk:                     {Financial_Common_C},
+	Financial_Quotes_ManualBidAndAsk:                           {Financial_Common_C},
+	Financial_Quotes_MarketMakerQuotesClosed:                   {Financial_Common_C},
+}
+
+// NBBOEligible returns whether or not either side of a quote with this rule may be included in the NBBO
+func (rule QuoteConditionRule) NBBOEligible() bool {
+	return rule.BidEligible || rule.AskEligible
+}
+
+// QuoteConditionRules returns the rule associated with a single Financial.Quotes.Condition reported on
+// the tape provided. Conditions which are not included in the condition table, or which are not defined
+// by the plan associated with the tape, will be treated as indicative quotes
+func QuoteConditionRules(cond Financial_Quotes_Condition, tape Financial_Common_Tape) QuoteConditionRule {
+
+	// First, check if the condition is specific to a plan; if it is and the tape isn't covered by that
+	// plan then we don't know how to interpret it so treat it as indicative
+	if tapes, ok := quoteConditionPlans[cond]; ok && !containsTape(tapes, tape) {
+		return indicativeQuote
+	}
+
+	// Next, attempt to retrieve the rule from the table; if it's not there then treat the quote as
+	// indicative. Otherwise, return the rule
+	if rule, ok := quoteConditionTable[cond]; ok {
+		return rule
+	}
+
+	return indicativeQuote
+}
+
+// LookupQuoteConditionRule returns the rule defined for a Financial.Quotes.Condition in the condition
+// table and whether or not the condition was found. Unlike QuoteConditionRules, this does not take the
+// plan associated with the tape into account
+func LookupQuoteConditionRule(cond Financial_Quotes_Condition) (QuoteConditionRule, bool) {
+	rule, ok := quoteConditionTable[cond]
+	return rule, ok
+}
+
+// LookupQuoteConditionTapes returns the tapes on which a plan-specific Financial.Quotes.Condition is
+// defined and whether or not the condition is plan-specific. The returned slice is a copy and so may
+// be modified by the caller
+func LookupQuoteConditionTapes(cond Financial_Quotes_Condition) ([]Financial_Common_Tape, bool) {
+	tapes, ok := quoteConditionPlans[cond]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]Financial_Common_Tape(nil), tapes...), true
+}
+
+// Helper function that determines whether or not a tape is included in a list of tapes
+func containsTape(tapes []Financial_Common_Tape, tape Financial_Common_Tape) bool {
+	for _, inner := range tapes {
+		if inner == tape {
+			return true
+		}
+	}
+
+	return false
+}
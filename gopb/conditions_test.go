@@ -0,0 +1,179 @@
+package gopb
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trade Condition Rules Tests", func() {
+
+	// Tests that every Financial.Trades.Condition has an entry in the condition matrix
+	It("LookupTradeConditionRule - All conditions included", func() {
+		for value, name := range Financial_Trades_Condition_name {
+			_, ok := LookupTradeConditionRule(Financial_Trades_Condition(value))
+			Expect(ok).Should(BeTrue(), "Condition %s was not included in the matrix", name)
+		}
+	})
+
+	// Tests that the LookupTradeConditionTapes function returns the tapes for plan-specific conditions
+	DescribeTable("LookupTradeConditionTapes - Works",
+		func(cond Financial_Trades_Condition, expected []Financial_Common_Tape, found bool) {
+			tapes, ok := LookupTradeConditionTapes(cond)
+			Expect(ok).Should(Equal(found))
+			Expect(tapes).Should(Equal(expected))
+		},
+		Entry("Opening Prints - UTP", Financial_Trades_OpeningPrints, []Financial_Common_Tape{Financial_Common_C}, true),
+		Entry("Rule 127 NYSE - CTA", Financial_Trades_Rule127NYSE,
+			[]Financial_Common_Tape{Financial_Common_A, Financial_Common_B}, true),
+		Entry("Regular Sale - Not plan-specific", Financial_Trades_RegularSale, nil, false))
+
+	// Tests that modifying the tapes returned by LookupTradeConditionTapes does not affect the plans
+	It("LookupTradeConditionTapes - Copied", func() {
+		tapes, ok := LookupTradeConditionTapes(Financial_Trades_OpeningPrints)
+		Expect(ok).Should(BeTrue())
+		tapes[0] = Financial_Common_A
+
+		Expect(TradeConditionRules(Financial_Trades_OpeningPrints, Financial_Common_A).Consolidated).
+			Should(Equal(TradeUpdateRules{UpdatesVolume: true}))
+		tapes, _ = LookupTradeConditionTapes(Financial_Trades_OpeningPrints)
+		Expect(tapes).Should(Equal([]Financial_Common_Tape{Financial_Common_C}))
+	})
+
+	// Tests that the And function only allows updates allowed by both update rules
+	It("TradeUpdateRules.And - Works", func() {
+		rules := TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesVolume: true}.
+			And(TradeUpdateRules{UpdatesHighLow: true, UpdatesOpenClose: true, UpdatesVolume: true})
+		Expect(rules).Should(Equal(TradeUpdateRules{UpdatesHighLow: true, UpdatesVolume: true}))
+	})
+
+	// Tests that the TradeConditionRules function returns the correct rules for various conditions
+	DescribeTable("TradeConditionRules - Works",
+		func(cond Financial_Trades_Condition, tape Financial_Common_Tape, consolidated TradeUpdateRules,
+			marketCenter TradeUpdateRules) {
+			rule := TradeConditionRules(cond, tape)
+			Expect(rule.Consolidated).Should(Equal(consolidated))
+			Expect(rule.MarketCenter).Should(Equal(marketCenter))
+		},
+		Entry("Regular Sale - Updates all", Financial_Trades_RegularSale, Financial_Common_A,
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true}),
+		Entry("Sold Out of Sequence - Updates high/low and volume", Financial_Trades_SoldOutOfSequence, Financial_Common_C,
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesVolume: true}),
+		Entry("Odd Lot - Updates volume", Financial_Trades_OddLotTrade, Financial_Common_B,
+			TradeUpdateRules{UpdatesVolume: true}, TradeUpdateRules{UpdatesVolume: true}),
+		Entry("Cancelled - Updates nothing", Financial_Trades_Cancelled, Financial_Common_A,
+			TradeUpdateRules{}, TradeUpdateRules{}),
+		Entry("Market Center Official Open - Updates market center", Financial_Trades_MarketCenterOfficialOpen,
+			Financial_Common_C, TradeUpdateRules{}, TradeUpdateRules{UpdatesHighLow: true, UpdatesOpenClose: true}),
+		Entry("Market Center Official Close - Updates market center", Financial_Trades_MarketCenterOfficialClose,
+			Financial_Common_C, TradeUpdateRules{},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true}),
+		Entry("Corrected Consolidated Close - Updates consolidated", Financial_Trades_CorrectedConsolidatedClose,
+			Financial_Common_A, TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true},
+			TradeUpdateRules{}),
+		Entry("Opening Prints, UTP - Updates all", Financial_Trades_OpeningPrints, Financial_Common_C,
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true}),
+		Entry("Opening Prints, CTA - Updates volume", Financial_Trades_OpeningPrints, Financial_Common_A,
+			TradeUpdateRules{UpdatesVolume: true}, TradeUpdateRules{UpdatesVolume: true}),
+		Entry("Market Center Opening Trade, CTA - Updates all", Financial_Trades_MarketCenterOpeningTrade,
+			Financial_Common_B,
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true}),
+		Entry("Market Center Opening Trade, UTP - Updates volume", Financial_Trades_MarketCenterOpeningTrade,
+			Financial_Common_C, TradeUpdateRules{UpdatesVolume: true}, TradeUpdateRules{UpdatesVolume: true}),
+		Entry("Unrecognized condition - Updates volume", Financial_Trades_Condition(61), Financial_Common_A,
+			TradeUpdateRules{UpdatesVolume: true}, TradeUpdateRules{UpdatesVolume: true}))
+
+	// Tests that the CombinedTradeConditionRules function applies the most restrictive rule
+	DescribeTable("CombinedTradeConditionRules - Works",
+		func(tape Financial_Common_Tape, conds []Financial_Trades_Condition, consolidated TradeUpdateRules,
+			marketCenter TradeUpdateRules) {
+			rule := CombinedTradeConditionRules(tape, conds...)
+			Expect(rule.Consolidated).Should(Equal(consolidated))
+			Expect(rule.MarketCenter).Should(Equal(marketCenter))
+		},
+		Entry("No conditions - Updates all", Financial_Common_A, []Financial_Trades_Condition{},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true}),
+		Entry("Intermarket Sweep, Sold Out of Sequence - Updates high/low and volume", Financial_Common_C,
+			[]Financial_Trades_Condition{Financial_Trades_IntermarketSweep, Financial_Trades_SoldOutOfSequence},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesVolume: true}),
+		Entry("Intermarket Sweep, Odd Lot - Updates volume", Financial_Common_A,
+			[]Financial_Trades_Condition{Financial_Trades_IntermarketSweep, Financial_Trades_OddLotTrade},
+			TradeUpdateRules{UpdatesVolume: true}, TradeUpdateRules{UpdatesVolume: true}),
+		Entry("Regular Sale, Short Sale Restriction - Updates all", Financial_Common_B,
+			[]Financial_Trades_Condition{Financial_Trades_RegularSale, Financial_Trades_ShortSaleRestrictionInEffect},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true},
+			TradeUpdateRules{UpdatesHighLow: true, UpdatesLast: true, UpdatesOpenClose: true, UpdatesVolume: true}),
+		Entry("Market Center Official Close, Odd Lot - Updates nothing", Financial_Common_C,
+			[]Financial_Trades_Condition{Financial_Trades_MarketCenterOfficialClose, Financial_Trades_OddLotTrade},
+			TradeUpdateRules{}, TradeUpdateRules{}))
+
+	// Tests that every Financial.Quotes.Condition has an entry in the condition table
+	It("LookupQuoteConditionRule - All conditions included", func() {
+		for value, name := range Financial_Quotes_Condition_name {
+			_, ok := LookupQuoteConditionRule(Financial_Quotes_Condition(value))
+			Expect(ok).Should(BeTrue(), "Condition %s was not included in the table", name)
+		}
+	})
+
+	// Tests that the LookupQuoteConditionTapes function returns the tapes for plan-specific conditions
+	DescribeTable("LookupQuoteConditionTapes - Works",
+		func(cond Financial_Quotes_Condition, expected []Financial_Common_Tape, found bool) {
+			tapes, ok := LookupQuoteConditionTapes(cond)
+			Expect(ok).Should(Equal(found))
+			Expect(tapes).Should(Equal(expected))
+		},
+		Entry("Manual Bid and Ask - UTP", Financial_Quotes_ManualBidAndAsk, []Financial_Common_Tape{Financial_Common_C}, true),
+		Entry("Order Influx - CTA", Financial_Quotes_OrderInflux,
+			[]Financial_Common_Tape{Financial_Common_A, Financial_Common_B}, true),
+		Entry("Regular - Not plan-specific", Financial_Quotes_Regular, nil, false))
+
+	// Tests that modifying the tapes returned by LookupQuoteConditionTapes does not affect the plans
+	It("LookupQuoteConditionTapes - Copied", func() {
+		tapes, ok := LookupQuoteConditionTapes(Financial_Quotes_ManualBidAndAsk)
+		Expect(ok).Should(BeTrue())
+		tapes[0] = Financial_Common_A
+
+		Expect(QuoteConditionRules(Financial_Quotes_ManualBidAndAsk, Financial_Common_A)).
+			Should(Equal(QuoteConditionRule{}))
+		tapes, _ = LookupQuoteConditionTapes(Financial_Quotes_ManualBidAndAsk)
+		Expect(tapes).Should(Equal([]Financial_Common_Tape{Financial_Common_C}))
+	})
+
+	// Tests that the QuoteConditionRules function returns the correct rules for various conditions
+	DescribeTable("QuoteConditionRules - Works",
+		func(cond Financial_Quotes_Condition, tape Financial_Common_Tape, expected QuoteConditionRule, eligible bool) {
+			rule := QuoteConditionRules(cond, tape)
+			Expect(rule).Should(Equal(expected))
+			Expect(rule.NBBOEligible()).Should(Equal(eligible))
+		},
+		Entry("Regular - Eligible, firm", Financial_Quotes_Regular, Financial_Common_A,
+			QuoteConditionRule{BidEligible: true, AskEligible: true, Firm: true}, true),
+		Entry("Regular Two-Sided Open - Eligible, opening", Financial_Quotes_RegularTwoSidedOpen, Financial_Common_C,
+			QuoteConditionRule{BidEligible: true, AskEligible: true, Firm: true, Opening: true}, true),
+		Entry("Closing - Eligible, closing", Financial_Quotes_Closing, Financial_Common_B,
+			QuoteConditionRule{BidEligible: true, AskEligible: true, Firm: true, Closing: true}, true),
+		Entry("Slow Ask - Bid eligible", Financial_Quotes_SlowAsk, Financial_Common_C,
+			QuoteConditionRule{BidEligible: true, Firm: true}, true),
+		Entry("Slow Bid - Ask eligible", Financial_Quotes_SlowBid, Financial_Common_A,
+			QuoteConditionRule{AskEligible: true, Firm: true}, true),
+		Entry("Slow Bid Ask - Not eligible, firm", Financial_Quotes_SlowBidAsk, Financial_Common_A,
+			QuoteConditionRule{Firm: true}, false),
+		Entry("Non-Firm - Indicative", Financial_Quotes_NonFirm, Financial_Common_C, QuoteConditionRule{}, false),
+		Entry("News Pending - Halted", Financial_Quotes_NewsPending, Financial_Common_A,
+			QuoteConditionRule{Halted: true}, false),
+		Entry("Pre-Opening Indication - Indicative, opening", Financial_Quotes_PreOpeningIndication,
+			Financial_Common_C, QuoteConditionRule{Opening: true}, false),
+		Entry("Manual Bid and Ask, UTP - Not eligible, firm", Financial_Quotes_ManualBidAndAsk, Financial_Common_C,
+			QuoteConditionRule{Firm: true}, false),
+		Entry("Manual Ask Automated Bid, CQS - Indicative", Financial_Quotes_ManualAskAutomatedBid,
+			Financial_Common_A, QuoteConditionRule{}, false),
+		Entry("Slow Due NYSE LRP, UTP - Indicative", Financial_Quotes_SlowDueNYSELRP, Financial_Common_C,
+			QuoteConditionRule{}, false),
+		Entry("Unrecognized condition - Indicative", Financial_Quotes_Condition(60), Financial_Common_A,
+			QuoteConditionRule{}, false))
+})
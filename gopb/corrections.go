@@ -0,0 +1,216 @@
+package gopb
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// TradeChangeType describes the effect that a trade record had on the set of effective trades
+type TradeChangeType int
+
+const (
+	TradeAdded     TradeChangeType = iota // A new trade was added to the effective trade set
+	TradeCorrected                        // An existing trade was replaced with corrected data
+	TradeCancelled                        // An existing trade was cancelled and removed
+	TradeErrored                          // An existing trade was marked as erroneous and removed
+)
+
+// String converts a TradeChangeType to its string representation
+func (change TradeChangeType) String() string {
+	switch change {
+	case TradeAdded:
+		return "Added"
+	case TradeCorrected:
+		return "Corrected"
+	case TradeCancelled:
+		return "Cancelled"
+	case TradeErrored:
+		return "Errored"
+	default:
+		return strconv.Itoa(int(change))
+	}
+}
+
+// TradeChange describes a single change to the set of effective trades. Previous will be nil if the
+// change added a new trade and Current will be nil if the change removed a trade. Record contains the
+// trade record that caused the change. Bars covering the timestamps of both the previous and current
+// trades should be re-aggregated when a change is received
+type TradeChange struct {
+	Type     TradeChangeType
+	Previous *Trade
+	Current  *Trade
+	Record   *Trade
+}
+
+// TradeReconciler pairs trade records with the corrections, cancels and errors that follow them,
+// maintaining the set of effective trades and a log of all the changes made to that set. Trades are
+// keyed by their ID or, if they have no ID, by their sequence number
+type TradeReconciler struct {
+	trades  map[string]*Trade
+	removed map[string]TradeChangeType
+	changes []*TradeChange
+}
+
+// NewTradeReconciler creates a new, empty TradeReconciler
+func NewTradeReconciler() *TradeReconciler {
+	return &TradeReconciler{
+		trades:  make(map[string]*Trade),
+		removed: make(map[string]TradeChangeType),
+		changes: make([]*TradeChange, 0),
+	}
+}
+
+// Apply applies a single trade record to the set of effective trades, returning the change that the
+// record caused or nil if the record did not change the set. Cancels and errors which arrive before
+// the trade they refer to are remembered so that the trade is not added when it does arrive
+func (reconciler *TradeReconciler) Apply(record *Trade) (*TradeChange, error) {
+
+	// First, determine the key of the trade the record refers to; if there is none then return an error
+	key := record.key()
+	if key == "" {
+		return nil, fmt.Errorf("trade record had neither an ID nor a sequence number")
+	}
+
+	// Next, determine what kind of change the record describes from its correction code and conditions
+	changeType := record.changeType()
+
+	// Now, if the record removes a trade then remove the trade from the set and remember that it was
+	// removed so that it won't be added if it arrives later
+	previous, exists := reconciler.trades[key]
+	if changeType == TradeCancelled || changeType == TradeErrored {
+		reconciler.removed[key] = changeType
+		if !exists {
+			return nil, nil
+		}
+
+		delete(reconciler.trades, key)
+		return reconciler.log(changeType, previous, nil, record), nil
+	}
+
+	// If the trade was already removed or the record is a correction record containing the original,
+	// incorrect data for the trade then it has no effect on the set
+	if _, ok := reconciler.removed[key]; ok || record.Correction == Financial_Trades_CorrectionRecord {
+		return nil, nil
+	}
+
+	// Finally, add the trade to the set, replacing any existing trade with the same key. If the trade
+	// already existed then the change is a correction; otherwise, it's an addition
+	current := record.effective()
+	reconciler.trades[key] = current
+	if exists {
+		return reconciler.log(TradeCorrected, previous, current, record), nil
+	}
+
+	return reconciler.log(TradeAdded, nil, current, record), nil
+}
+
+// ApplyAll applies a series of trade records to the set of effective trades, in order, returning the
+// changes that resulted. If any record fails to apply then an error will be returned
+func (reconciler *TradeReconciler) ApplyAll(records ...*Trade) ([]*TradeChange, error) {
+	changes := make([]*TradeChange, 0)
+	for _, record := range records {
+		change, err := reconciler.Apply(record)
+		if err != nil {
+			return nil, err
+		} else if change != nil {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+// Trades returns the set of effective trades, sorted by timestamp and then by sequence number
+func (reconciler *TradeReconciler) Trades() []*Trade {
+	trades := make([]*Trade, 0, len(reconciler.trades))
+	for _, trade := range reconciler.trades {
+		trades = append(trades, trade)
+	}
+
+	sort.Slice(trades, func(i, j int) bool {
+		if trades[i].Timestamp.Equals(trades[j].Timestamp) {
+			return trades[i].SequenceNumber < trades[j].SequenceNumber
+		}
+
+		return trades[i].Timestamp.LessThan(trades[j].Timestamp)
+	})
+
+	return trades
+}
+
+// Changes returns the log of all changes made to the set of effective trades, in the order they were made
+func (reconciler *TradeReconciler) Changes() []*TradeChange {
+	return append([]*TradeChange(nil), reconciler.changes...)
+}
+
+// Helper function that creates a new change, adds it to the change log and returns it
+func (reconciler *TradeReconciler) log(changeType TradeChangeType, previous *Trade, current *Trade,
+	record *Trade) *TradeChange {
+	change := TradeChange{Type: changeType, Previous: previous, Current: current, Record: record}
+	reconciler.changes = append(reconciler.changes, &change)
+	return &change
+}
+
+// Helper function that returns the key used to pair a trade with the records that modify it
+func (trade *Trade) key() string {
+	if trade.ID != "" {
+		return trade.ID
+	} else if trade.SequenceNumber != 0 {
+		return strconv.FormatInt(trade.SequenceNumber, 10)
+	}
+
+	return ""
+}
+
+// Helper function that determines what kind of change a trade record describes. Records whose
+// correction code or conditions mark them as cancelled or erroneous remove the trade they refer to,
+// records marked as corrections replace it and all other records add it
+func (trade *Trade) changeType() TradeChangeType {
+
+	// First, check the correction code on the record
+	switch trade.Correction {
+	case Financial_Trades_Cancel, Financial_Trades_CancelRecord:
+		return TradeCancelled
+	case Financial_Trades_Erroneous, Financial_Trades_ErrorRecord:
+		return TradeErrored
+	case Financial_Trades_LateCorrected:
+		return TradeCorrected
+	}
+
+	// Next, check the conditions on the record
+	for _, cond := range trade.Conditions {
+		switch cond {
+		case Financial_Trades_Cancelled, Financial_Trades_Canceled, Financial_Trades_LastAndCanceled,
+			Financial_Trades_OpeningTradeAndCanceled, Financial_Trades_OnlyTradeAndCanceled,
+			Financial_Trades_AsOfCancel:
+			return TradeCancelled
+		case Financial_Trades_Errored:
+			return TradeErrored
+		case Financial_Trades_Correction, Financial_Trades_AsOfCorrection:
+			return TradeCorrected
+		}
+	}
+
+	// Finally, if we reached this point then the record is a regular trade
+	return TradeAdded
+}
+
+// Helper function that creates the effective trade associated with a trade record. Conditions that
+// only describe the record as a correction are removed so that the effective trade is aggregated in
+// the same way as the original trade would have been. As-of corrections remain as-of trades
+func (trade *Trade) effective() *Trade {
+	effective := *trade
+	effective.Conditions = make([]Financial_Trades_Condition, 0, len(trade.Conditions))
+	for _, cond := range trade.Conditions {
+		switch cond {
+		case Financial_Trades_Correction:
+		case Financial_Trades_AsOfCorrection:
+			effective.Conditions = append(effective.Conditions, Financial_Trades_AsOf)
+		default:
+			effective.Conditions = append(effective.Conditions, cond)
+		}
+	}
+
+	return &effective
+}
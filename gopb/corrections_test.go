@@ -0,0 +1,163 @@
+package gopb
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trade Reconciliation Tests", func() {
+
+	// Helper function that creates a trade record from an ID, offset, price, size, correction code and
+	// conditions
+	record := func(id string, offset time.Duration, price string, size string,
+		correction Financial_Trades_CorrectionCode, conds ...Financial_Trades_Condition) *Trade {
+		record := trade(offset, price, size, conds...)
+		record.ID, record.Correction = id, correction
+		return record
+	}
+
+	// Helper function that extracts the IDs of a collection of trades
+	ids := func(trades []*Trade) []string {
+		ids := make([]string, len(trades))
+		for i, trade := range trades {
+			ids[i] = trade.ID
+		}
+
+		return ids
+	}
+
+	// Tests that the String function converts a TradeChangeType to its string representation
+	DescribeTable("TradeChangeType.String - Works",
+		func(change TradeChangeType, expected string) {
+			Expect(change.String()).Should(Equal(expected))
+		},
+		Entry("Added - Works", TradeAdded, "Added"),
+		Entry("Corrected - Works", TradeCorrected, "Corrected"),
+		Entry("Cancelled - Works", TradeCancelled, "Cancelled"),
+		Entry("Errored - Works", TradeErrored, "Errored"),
+		Entry("Unknown - Works", TradeChangeType(7), "7"))
+
+	// Tests that the Apply function returns an error if the record cannot be paired with a trade
+	It("Apply - No ID or sequence number - Error", func() {
+		reconciler := NewTradeReconciler()
+		change, err := reconciler.Apply(record("", 0, "10", "100", Financial_Trades_NotCorrected))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("trade record had neither an ID nor a sequence number"))
+		Expect(change).Should(BeNil())
+	})
+
+	// Tests that the Apply function pairs correction codes with the trades they refer to
+	It("Apply - Correction codes - Works", func() {
+		reconciler := NewTradeReconciler()
+
+		// First, add some regular trades; each should be added to the set
+		changes, err := reconciler.ApplyAll(
+			record("1", 5*time.Second, "10", "100", Financial_Trades_NotCorrected),
+			record("2", 10*time.Second, "11", "50", Financial_Trades_NotCorrected),
+			record("3", 20*time.Second, "12", "200", Financial_Trades_NotCorrected))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(changes).Should(HaveLen(3))
+		for _, change := range changes {
+			Expect(change.Type).Should(Equal(TradeAdded))
+			Expect(change.Previous).Should(BeNil())
+		}
+
+		// Next, cancel the second trade; it should be removed from the set
+		change, err := reconciler.Apply(record("2", 10*time.Second, "11", "50", Financial_Trades_CancelRecord))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change.Type).Should(Equal(TradeCancelled))
+		Expect(change.Previous.ID).Should(Equal("2"))
+		Expect(change.Current).Should(BeNil())
+
+		// Now, correct the third trade; the correction record with the original data should be ignored
+		// and the original trade with the corrected data should replace the trade
+		change, err = reconciler.Apply(record("3", 20*time.Second, "12", "200", Financial_Trades_CorrectionRecord))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change).Should(BeNil())
+		change, err = reconciler.Apply(record("3", 20*time.Second, "12.5", "100", Financial_Trades_LateCorrected))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change.Type).Should(Equal(TradeCorrected))
+		Expect(change.Previous.Price.ToString()).Should(Equal("12"))
+		Expect(change.Current.Price.ToString()).Should(Equal("12.5"))
+
+		// Mark the first trade as erroneous; it should be removed from the set
+		change, err = reconciler.Apply(record("1", 5*time.Second, "10", "100", Financial_Trades_ErrorRecord))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change.Type).Should(Equal(TradeErrored))
+
+		// Finally, verify the effective trade set and the change log
+		trades := reconciler.Trades()
+		Expect(ids(trades)).Should(Equal([]string{"3"}))
+		Expect(trades[0].Size.ToString()).Should(Equal("100"))
+		Expect(reconciler.Changes()).Should(HaveLen(6))
+	})
+
+	// Tests that the Apply function handles cancels which arrive before the trade they refer to
+	It("Apply - Cancel before trade - Trade not added", func() {
+		reconciler := NewTradeReconciler()
+
+		// First, cancel a trade that hasn't arrived yet; this should have no effect
+		change, err := reconciler.Apply(record("1", 5*time.Second, "10", "100", Financial_Trades_CancelRecord))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change).Should(BeNil())
+
+		// Next, add the trade that was cancelled; this should also have no effect
+		change, err = reconciler.Apply(record("1", 5*time.Second, "10", "100", Financial_Trades_NotCorrected))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change).Should(BeNil())
+		Expect(reconciler.Trades()).Should(BeEmpty())
+		Expect(reconciler.Changes()).Should(BeEmpty())
+	})
+
+	// Tests that the Apply function handles correction and cancellation conditions
+	It("Apply - Conditions - Works", func() {
+		reconciler := NewTradeReconciler()
+		_, err := reconciler.ApplyAll(
+			record("1", 5*time.Second, "10", "100", Financial_Trades_NotCorrected),
+			record("2", 10*time.Second, "11", "50", Financial_Trades_NotCorrected),
+			record("3", 20*time.Second, "12", "200", Financial_Trades_NotCorrected, Financial_Trades_AsOf))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// First, correct the first trade with a Correction condition; the condition should be removed
+		change, err := reconciler.Apply(record("1", 5*time.Second, "10.1", "100",
+			Financial_Trades_NotCorrected, Financial_Trades_Correction))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change.Type).Should(Equal(TradeCorrected))
+		Expect(change.Current.Conditions).Should(BeEmpty())
+
+		// Next, correct the third trade with an AsOfCorrection condition; the trade should remain as-of
+		change, err = reconciler.Apply(record("3", 20*time.Second, "12.1", "200",
+			Financial_Trades_NotCorrected, Financial_Trades_AsOfCorrection))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change.Type).Should(Equal(TradeCorrected))
+		Expect(change.Current.Conditions).Should(Equal([]Financial_Trades_Condition{Financial_Trades_AsOf}))
+
+		// Finally, cancel the second trade with an AsOfCancel condition; it should be removed
+		change, err = reconciler.Apply(record("2", 10*time.Second, "11", "50",
+			Financial_Trades_NotCorrected, Financial_Trades_AsOfCancel))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(change.Type).Should(Equal(TradeCancelled))
+		Expect(ids(reconciler.Trades())).Should(Equal([]string{"1", "3"}))
+	})
+
+	// Tests that the effective trade set can be used to re-aggregate bars after a correction
+	It("Trades - Re-aggregate bars - Works", func() {
+		reconciler := NewTradeReconciler()
+		_, err := reconciler.ApplyAll(
+			record("1", 5*time.Second, "10", "100", Financial_Trades_NotCorrected),
+			record("2", 10*time.Second, "11", "50", Financial_Trades_NotCorrected),
+			record("2", 10*time.Second, "11", "50", Financial_Trades_CancelRecord),
+			record("3", 20*time.Second, "12", "200", Financial_Trades_NotCorrected),
+			record("3", 20*time.Second, "12", "100", Financial_Trades_NotCorrected, Financial_Trades_Correction))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		bars := AggregateBars(time.Minute, reconciler.Trades())
+		Expect(bars).Should(HaveLen(1))
+		Expect(bars[0].Open.ToString()).Should(Equal("10"))
+		Expect(bars[0].Close.ToString()).Should(Equal("12"))
+		Expect(bars[0].Volume.ToString()).Should(Equal("200"))
+		Expect(bars[0].TradeCount).Should(Equal(int64(2)))
+	})
+})
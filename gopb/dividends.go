@@ -0,0 +1,216 @@
+package gopb
+
+import (
+	"fmt"
+	"time"
+)
+
+// DividendEvent describes a single dividend distribution, either one that has already been declared
+// or one that has been projected from a previous distribution
+type DividendEvent struct {
+	ExDividendDate *UnixTimestamp
+	RecordDate     *UnixTimestamp
+	PayDate        *UnixTimestamp
+	CashAmount     *Decimal
+	Type           Financial_Dividends_Type
+}
+
+// DividendProjector generates expected future dividend events from previous dividend events. Projected
+// dates are always moved forward to the next trading day, where a trading day is any weekday that has
+// not been registered as a holiday with the projector
+type DividendProjector struct {
+	RecordLag int // Number of trading days between the ex-dividend date and the record date
+	PayLag    int // Number of trading days between the record date and the pay date
+	holidays  map[string]bool
+}
+
+// NewDividendProjector creates a new DividendProjector from the number of trading days between the
+// ex-dividend and record dates, the number of trading days between the record and pay dates and a list
+// of market holidays that should not be considered trading days
+func NewDividendProjector(recordLag int, payLag int, holidays ...*UnixTimestamp) *DividendProjector {
+	projector := DividendProjector{
+		RecordLag: recordLag,
+		PayLag:    payLag,
+		holidays:  make(map[string]bool),
+	}
+
+	for _, holiday := range holidays {
+		projector.holidays[holiday.ToDate()] = true
+	}
+
+	return &projector
+}
+
+// IsRecurring returns true if the dividend type represents a regular, recurring cash distribution, or
+// false if it represents a special cash distribution, a capital gains distribution or some other type
+// of distribution that should not be expected to repeat
+func (enum Financial_Dividends_Type) IsRecurring() bool {
+	return enum == Financial_Dividends_CD
+}
+
+// Months returns the number of months between dividend payments for the frequency. If the frequency
+// does not describe a regular payment schedule then zero will be returned
+func (enum Financial_Dividends_Frequency) Months() int {
+	switch enum {
+	case Financial_Dividends_Annually, Financial_Dividends_SemiAnnually,
+		Financial_Dividends_Quarterly, Financial_Dividends_Monthly:
+		return 12 / int(enum)
+	default:
+		return 0
+	}
+}
+
+// IsTradingDay returns true if the day associated with the timestamp is a weekday that has not been
+// registered as a holiday with the projector, or false otherwise
+func (projector *DividendProjector) IsTradingDay(timestamp *UnixTimestamp) bool {
+	switch timestamp.AsTime().Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	default:
+		return !projector.holidays[timestamp.ToDate()]
+	}
+}
+
+// NextTradingDay returns the start of the day associated with the timestamp if that day is a trading
+// day; otherwise, the start of the first trading day after the timestamp will be returned
+func (projector *DividendProjector) NextTradingDay(timestamp *UnixTimestamp) *UnixTimestamp {
+	day := timestamp.DayDown()
+	for !projector.IsTradingDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return day
+}
+
+// AddTradingDays returns the start of the day that falls a number of trading days after the timestamp.
+// If the timestamp does not fall on a trading day then it will first be moved to the next trading day
+func (projector *DividendProjector) AddTradingDays(timestamp *UnixTimestamp, days int) *UnixTimestamp {
+	day := projector.NextTradingDay(timestamp)
+	for i := 0; i < days; i++ {
+		day = projector.NextTradingDay(day.AddDate(0, 0, 1))
+	}
+
+	return day
+}
+
+// TradingDaysBetween returns the number of trading days that elapse when moving from the start timestamp
+// to the end timestamp. If the end timestamp is before the start timestamp then zero will be returned
+func (projector *DividendProjector) TradingDaysBetween(start *UnixTimestamp, end *UnixTimestamp) int {
+	count := 0
+	last := end.DayDown()
+	for day := projector.NextTradingDay(start); ; count++ {
+		day = projector.NextTradingDay(day.AddDate(0, 0, 1))
+		if day.GreaterThan(last) {
+			return count
+		}
+	}
+}
+
+// Helper function that returns the trading day on which a dividend scheduled a number of months after the
+// base date should go ex-dividend. If the base date falls on a day that does not exist in the target month,
+// such as the 31st, then the last day of that month is used instead. The date is then moved forward to the
+// next trading day unless that would move it into the following month, in which case it is moved back to
+// the previous trading day instead, so that each month in the schedule receives exactly one dividend
+func (projector *DividendProjector) scheduledDate(base *UnixTimestamp, months int) *UnixTimestamp {
+
+	// First, find the target day, clamped to the last day of the target month
+	start := base.AsTime()
+	first := time.Date(start.Year(), start.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	day := start.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+
+	target := NewFromTime(first.AddDate(0, 0, day-1))
+
+	// Next, move the target forward to the next trading day, provided this stays within the month
+	next := projector.NextTradingDay(target)
+	if next.AsTime().Month() == first.Month() {
+		return next
+	}
+
+	// Finally, the month ends on a non-trading day so move the target back to the previous trading day
+	for !projector.IsTradingDay(target) {
+		target = target.AddDate(0, 0, -1)
+	}
+
+	return target
+}
+
+// Project generates all the dividend events expected to go ex-dividend after the last ex-dividend date
+// but no later than the horizon. Each projected event will pay the same cash amount as the last
+// dividend and will be spaced according to the frequency, with dates near the end of a month kept
+// within the month they are scheduled for. This function will return an error if the frequency does
+// not describe a regular payment schedule or if the dividend type is not recurring
+func (projector *DividendProjector) Project(lastExDate *UnixTimestamp, amount *Decimal,
+	frequency Financial_Dividends_Frequency, dividendType Financial_Dividends_Type,
+	horizon *UnixTimestamp) ([]*DividendEvent, error) {
+
+	// First, check that the frequency and type can be used to generate a schedule; if either of these
+	// can't then return an error
+	months := frequency.Months()
+	if months == 0 {
+		return nil, fmt.Errorf("dividends with a frequency of %s cannot be projected", frequency)
+	} else if !dividendType.IsRecurring() {
+		return nil, fmt.Errorf("dividends with a type of %s are not recurring and cannot be projected", dividendType)
+	}
+
+	// Next, step forward from the last ex-dividend date by the number of months associated with the
+	// frequency. Each step is calculated from the last ex-dividend date rather than the previous step so
+	// that moving a date off of a non-trading day does not cause the schedule to drift
+	events := make([]*DividendEvent, 0)
+	base := lastExDate.DayDown()
+	for i := 1; ; i++ {
+
+		// Calculate the ex-dividend date for this step; if it falls after the horizon then we're done
+		exDate := projector.scheduledDate(base, i*months)
+		if exDate.GreaterThan(horizon) {
+			break
+		}
+
+		// Calculate the record and pay dates from the ex-dividend date and add the event to our list
+		recordDate := projector.AddTradingDays(exDate, projector.RecordLag)
+		events = append(events, &DividendEvent{
+			ExDividendDate: exDate,
+			RecordDate:     recordDate,
+			PayDate:        projector.AddTradingDays(recordDate, projector.PayLag),
+			CashAmount:     &Decimal{Parts: append([]int64(nil), amount.GetParts()...), Exp: amount.GetExp()},
+			Type:           dividendType,
+		})
+	}
+
+	// Finally, return the projected events
+	return events, nil
+}
+
+// ProjectFromHistory generates all the dividend events expected to go ex-dividend after the latest
+// recurring dividend in the history but no later than the horizon. Special and capital gains
+// distributions are ignored. If the latest recurring dividend includes record and pay dates then the
+// lags between these will be used for the projection instead of the lags on the projector
+func (projector *DividendProjector) ProjectFromHistory(history []*DividendEvent,
+	frequency Financial_Dividends_Frequency, horizon *UnixTimestamp) ([]*DividendEvent, error) {
+
+	// First, find the latest recurring dividend in the history; if there is none then return an error
+	var last *DividendEvent
+	for _, event := range history {
+		if event.Type.IsRecurring() && (last == nil || event.ExDividendDate.GreaterThan(last.ExDividendDate)) {
+			last = event
+		}
+	}
+
+	if last == nil {
+		return nil, fmt.Errorf("no recurring dividend could be found from which to project")
+	}
+
+	// Next, infer the lags from the dividend if it has the dates necessary to do so
+	inner := *projector
+	if last.RecordDate != nil {
+		inner.RecordLag = projector.TradingDaysBetween(last.ExDividendDate, last.RecordDate)
+		if last.PayDate != nil {
+			inner.PayLag = projector.TradingDaysBetween(last.RecordDate, last.PayDate)
+		}
+	}
+
+	// Finally, project the dividend schedule from the latest recurring dividend
+	return inner.Project(last.ExDividendDate, last.CashAmount, frequency, last.Type, horizon)
+}
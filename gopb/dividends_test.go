@@ -0,0 +1,174 @@
+package gopb
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dividend Projection Tests", func() {
+
+	// Tests that the IsRecurring function works for all dividend types
+	DescribeTable("Financial.Dividends.Type.IsRecurring - Works",
+		func(dividendType Financial_Dividends_Type, expected bool) {
+			Expect(dividendType.IsRecurring()).Should(Equal(expected))
+		},
+		Entry("CD - True", Financial_Dividends_CD, true),
+		Entry("SC - False", Financial_Dividends_SC, false),
+		Entry("LT - False", Financial_Dividends_LT, false),
+		Entry("ST - False", Financial_Dividends_ST, false),
+		Entry("NP - False", Financial_Dividends_NP, false))
+
+	// Tests that the Months function works for all dividend frequencies
+	DescribeTable("Financial.Dividends.Frequency.Months - Works",
+		func(frequency Financial_Dividends_Frequency, expected int) {
+			Expect(frequency.Months()).Should(Equal(expected))
+		},
+		Entry("NoFrequency - 0", Financial_Dividends_NoFrequency, 0),
+		Entry("Annually - 12", Financial_Dividends_Annually, 12),
+		Entry("SemiAnnually - 6", Financial_Dividends_SemiAnnually, 6),
+		Entry("Quarterly - 3", Financial_Dividends_Quarterly, 3),
+		Entry("Monthly - 1", Financial_Dividends_Monthly, 1),
+		Entry("Invalid - 0", Financial_Dividends_Invalid, 0))
+
+	// Tests that the IsTradingDay function works under various data conditions
+	DescribeTable("IsTradingDay - Works",
+		func(timestamp *UnixTimestamp, expected bool) {
+			projector := NewDividendProjector(0, 0, date(2022, time.July, 4))
+			Expect(projector.IsTradingDay(timestamp)).Should(Equal(expected))
+		},
+		Entry("Weekday - True", date(2022, time.July, 5), true),
+		Entry("Saturday - False", date(2022, time.July, 2), false),
+		Entry("Sunday - False", date(2022, time.July, 3), false),
+		Entry("Holiday - False", NewUnixTimestamp(date(2022, time.July, 4).Seconds+3600, 0), false))
+
+	// Tests that the AddTradingDays function skips weekends and holidays
+	DescribeTable("AddTradingDays - Works",
+		func(timestamp *UnixTimestamp, days int, expected *UnixTimestamp) {
+			projector := NewDividendProjector(0, 0, date(2022, time.July, 4))
+			Expect(projector.AddTradingDays(timestamp, days)).Should(Equal(expected))
+		},
+		Entry("Zero days, trading day - Same day", date(2022, time.July, 1), 0, date(2022, time.July, 1)),
+		Entry("Zero days, weekend - Next trading day", date(2022, time.July, 2), 0, date(2022, time.July, 5)),
+		Entry("Over weekend and holiday - Skipped", date(2022, time.July, 1), 1, date(2022, time.July, 5)),
+		Entry("Multiple weeks - Skipped", date(2022, time.July, 1), 10, date(2022, time.July, 18)))
+
+	// Tests that the TradingDaysBetween function counts weekends and holidays correctly
+	DescribeTable("TradingDaysBetween - Works",
+		func(start *UnixTimestamp, end *UnixTimestamp, expected int) {
+			projector := NewDividendProjector(0, 0, date(2022, time.July, 4))
+			Expect(projector.TradingDaysBetween(start, end)).Should(Equal(expected))
+		},
+		Entry("Same day - 0", date(2022, time.July, 1), date(2022, time.July, 1), 0),
+		Entry("End before start - 0", date(2022, time.July, 5), date(2022, time.July, 1), 0),
+		Entry("Over weekend and holiday - 1", date(2022, time.July, 1), date(2022, time.July, 5), 1),
+		Entry("Multiple weeks - 10", date(2022, time.July, 1), date(2022, time.July, 18), 10))
+
+	// Tests that the Project function returns an error if the frequency is not regular
+	It("Project - Frequency not regular - Error", func() {
+		projector := NewDividendProjector(0, 0)
+		events, err := projector.Project(date(2022, time.March, 10), &Decimal{Parts: []int64{23}, Exp: -2},
+			Financial_Dividends_NoFrequency, Financial_Dividends_CD, date(2022, time.December, 31))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("dividends with a frequency of NoFrequency cannot be projected"))
+		Expect(events).Should(BeNil())
+	})
+
+	// Tests that the Project function returns an error if the dividend type is not recurring
+	It("Project - Type not recurring - Error", func() {
+		projector := NewDividendProjector(0, 0)
+		events, err := projector.Project(date(2022, time.March, 10), &Decimal{Parts: []int64{23}, Exp: -2},
+			Financial_Dividends_Quarterly, Financial_Dividends_SC, date(2022, time.December, 31))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("dividends with a type of SC are not recurring and cannot be projected"))
+		Expect(events).Should(BeNil())
+	})
+
+	// Tests that the Project function generates a schedule that skips non-trading days
+	It("Project - Works", func() {
+
+		// First, create our projector with a holiday that falls on one of the ex-dividend dates
+		projector := NewDividendProjector(1, 10, date(2022, time.June, 10))
+
+		// Next, project a quarterly dividend through the end of the year
+		events, err := projector.Project(date(2022, time.March, 10), &Decimal{Parts: []int64{23}, Exp: -2},
+			Financial_Dividends_Quarterly, Financial_Dividends_CD, date(2022, time.December, 31))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Finally, verify the projected events
+		Expect(events).Should(HaveLen(3))
+		Expect(events[0].ExDividendDate).Should(Equal(date(2022, time.June, 13)))
+		Expect(events[0].RecordDate).Should(Equal(date(2022, time.June, 14)))
+		Expect(events[0].PayDate).Should(Equal(date(2022, time.June, 28)))
+		Expect(events[0].CashAmount.ToString()).Should(Equal("0.23"))
+		Expect(events[0].Type).Should(Equal(Financial_Dividends_CD))
+		Expect(events[1].ExDividendDate).Should(Equal(date(2022, time.September, 12)))
+		Expect(events[1].RecordDate).Should(Equal(date(2022, time.September, 13)))
+		Expect(events[1].PayDate).Should(Equal(date(2022, time.September, 27)))
+		Expect(events[2].ExDividendDate).Should(Equal(date(2022, time.December, 12)))
+		Expect(events[2].RecordDate).Should(Equal(date(2022, time.December, 13)))
+		Expect(events[2].PayDate).Should(Equal(date(2022, time.December, 27)))
+	})
+
+	// Tests that the Project function clamps dates scheduled near the end of a month to that month, rather
+	// than rolling them over into the following month, including when the month ends on a weekend
+	It("Project - Month end - Works", func() {
+		projector := NewDividendProjector(0, 0)
+		events, err := projector.Project(date(2022, time.January, 31), &Decimal{Parts: []int64{5}, Exp: -2},
+			Financial_Dividends_Monthly, Financial_Dividends_CD, date(2022, time.June, 30))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		exDates := make([]*UnixTimestamp, len(events))
+		for i, event := range events {
+			exDates[i] = event.ExDividendDate
+		}
+
+		Expect(exDates).Should(Equal([]*UnixTimestamp{date(2022, time.February, 28), date(2022, time.March, 31),
+			date(2022, time.April, 29), date(2022, time.May, 31), date(2022, time.June, 30)}))
+	})
+
+	// Tests that the ProjectFromHistory function returns an error if there are no recurring dividends
+	It("ProjectFromHistory - No recurring dividends - Error", func() {
+		projector := NewDividendProjector(0, 0)
+		events, err := projector.ProjectFromHistory([]*DividendEvent{
+			{ExDividendDate: date(2022, time.March, 10), Type: Financial_Dividends_SC},
+			{ExDividendDate: date(2022, time.April, 10), Type: Financial_Dividends_LT},
+		}, Financial_Dividends_Quarterly, date(2022, time.December, 31))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("no recurring dividend could be found from which to project"))
+		Expect(events).Should(BeNil())
+	})
+
+	// Tests that the ProjectFromHistory function ignores non-recurring dividends and infers the lags
+	It("ProjectFromHistory - Works", func() {
+
+		// First, create our projector and project from a history that ends with a special dividend
+		projector := NewDividendProjector(0, 0)
+		events, err := projector.ProjectFromHistory([]*DividendEvent{
+			{
+				ExDividendDate: date(2022, time.January, 7),
+				RecordDate:     date(2022, time.January, 10),
+				PayDate:        date(2022, time.January, 14),
+				CashAmount:     &Decimal{Parts: []int64{5}, Exp: -1},
+				Type:           Financial_Dividends_CD,
+			},
+			{
+				ExDividendDate: date(2022, time.March, 10),
+				CashAmount:     &Decimal{Parts: []int64{3}},
+				Type:           Financial_Dividends_SC,
+			},
+		}, Financial_Dividends_SemiAnnually, date(2023, time.January, 31))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Finally, verify the projected events
+		Expect(events).Should(HaveLen(2))
+		Expect(events[0].ExDividendDate).Should(Equal(date(2022, time.July, 7)))
+		Expect(events[0].RecordDate).Should(Equal(date(2022, time.July, 8)))
+		Expect(events[0].PayDate).Should(Equal(date(2022, time.July, 14)))
+		Expect(events[0].CashAmount.ToString()).Should(Equal("0.5"))
+		Expect(events[1].ExDividendDate).Should(Equal(date(2023, time.January, 9)))
+		Expect(events[1].RecordDate).Should(Equal(date(2023, time.January, 10)))
+		Expect(events[1].PayDate).Should(Equal(date(2023, time.January, 16)))
+	})
+})
@@ -0,0 +1,26 @@
+package gopb
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("EnumSet Tests", func() {
+
+	// Tests that modifying a copy of a set does not modify the original, since sets behave as values
+	It("EnumSet - Copies modified independently - Works", func() {
+		original := utils.NewEnumSet(Financial_Trades_RegularSale, Financial_Trades_Acquisition)
+
+		added := original
+		Expect(added.Add(Financial_Trades_CashSale)).ShouldNot(HaveOccurred())
+		removed := original
+		removed.Remove(Financial_Trades_RegularSale)
+
+		Expect(original.Values()).Should(Equal([]Financial_Trades_Condition{
+			Financial_Trades_RegularSale, Financial_Trades_Acquisition}))
+		Expect(added.Values()).Should(Equal([]Financial_Trades_Condition{
+			Financial_Trades_RegularSale, Financial_Trades_Acquisition, Financial_Trades_CashSale}))
+		Expect(removed.Values()).Should(Equal([]Financial_Trades_Condition{Financial_Trades_Acquisition}))
+	})
+})
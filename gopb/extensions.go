@@ -1,15 +1,30 @@
 package gopb
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/utils"
 )
 
+// The layout of an xs:dateTime value that has no time zone
+const localDateTime = "2006-01-02T15:04:05.999999999"
+
+// The format of an xs:duration value. The groups contain the sign, the years, months, days, hours, minutes
+// and seconds, and then the fractional seconds
+var iso8601Duration = regexp.MustCompile(
+	`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:\.(\d{1,9}))?S)?)?$`)
+
+// Returned when an xs:duration contains years or months, which cannot be converted to a fixed length
+var errVariableLength = errors.New("years and months do not have a fixed length")
+
 // Size of the integer values we want to save (designed to fit inside an int64)
 var offset = big.NewInt(1e18)
 
@@ -74,11 +89,12 @@ func (d *Decimal) ToString() string {
 	return d.ToDecimal().String()
 }
 
-// FromString converts a string representation to a Decimal object
+// FromString converts a string representation to a Decimal object. If the string cannot be parsed then a
+// utils.ParseError will be returned
 func (d *Decimal) FromString(raw string) error {
 	dec, err := decimal.NewFromString(raw)
 	if err != nil {
-		return err
+		return &utils.ParseError{Type: "Decimal", Input: raw, Position: -1, Err: err}
 	}
 
 	*d = *NewFromDecimal(dec)
@@ -500,22 +516,36 @@ func (x *UnixTimestamp) IsValid() bool {
 
 // CheckValid returns an error if the timestamp is invalid. In particular, it checks whether the value
 // represents a date that is in the range of 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.
-// An error is reported for a nil Timestamp.
+// An error wrapping utils.ErrNil is reported for a nil Timestamp, and a utils.RangeError otherwise.
 func (x *UnixTimestamp) CheckValid() error {
 	switch x.check() {
 	case invalidNil:
-		return fmt.Errorf("invalid nil Timestamp")
+		return fmt.Errorf("%w Timestamp", utils.ErrNil)
 	case invalidUnderflow:
-		return fmt.Errorf("timestamp (%d, %d) before 0001-01-01", x.Seconds, x.Nanoseconds)
+		return x.rangeError("timestamp", "0001-01-01", utils.ErrUnderflow)
 	case invalidOverflow:
-		return fmt.Errorf("timestamp (%d, %d) after 9999-12-31", x.Seconds, x.Nanoseconds)
+		return x.rangeError("timestamp", "9999-12-31", utils.ErrOverflow)
 	case invalidNanos:
-		return fmt.Errorf("timestamp (%d, %d) has out-of-range nanos", x.Seconds, x.Nanoseconds)
+		if x.Nanoseconds < 0 {
+			return x.rangeError("timestamp nanos", "0", utils.ErrUnderflow)
+		}
+
+		return x.rangeError("timestamp nanos", "999999999", utils.ErrOverflow)
 	default:
 		return nil
 	}
 }
 
+// Helper function that creates a range error for a timestamp
+func (x *UnixTimestamp) rangeError(kind string, bound string, err error) error {
+	return &utils.RangeError{
+		Type:  kind,
+		Value: fmt.Sprintf("%d, %d", x.Seconds, x.Nanoseconds),
+		Bound: bound,
+		Err:   err,
+	}
+}
+
 // ToDate converts a UnixTimestamp to a date string
 func (timestamp *UnixTimestamp) ToDate() string {
 	time := timestamp.AsTime()
@@ -543,20 +573,20 @@ func (timestamp *UnixTimestamp) FromString(raw string) error {
 		timestamp = nil
 		return nil
 	} else if len(raw) < 10 {
-		return fmt.Errorf("value (%s) was not long enough to be converted to a timestamp", raw)
+		return &utils.ParseError{Type: "UnixTimestamp", Input: raw, Position: len(raw), Err: utils.ErrTooShort}
 	}
 
 	// Next, attempt to parse the number of seconds to a 64-bit integer. If this fails then return an error
 	partition := len(raw) - 9
 	seconds, err := strconv.ParseInt(raw[:partition], 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to convert seconds part to integer, error: %v", err)
+		return &utils.ParseError{Type: "UnixTimestamp", Input: raw, Position: 0, Err: err}
 	}
 
 	// Now, attempt to parse the number of nanoseconds to a 32-bit integer. If this fails then return an error
 	nanos, err := strconv.ParseInt(raw[partition:], 10, 32)
 	if err != nil {
-		return fmt.Errorf("failed to convert nanoseconds part to integer, error: %v", err)
+		return &utils.ParseError{Type: "UnixTimestamp", Input: raw, Position: partition, Err: err}
 	}
 
 	// Finally, create a new timestamp from the seconds and nanoseconds and then check that the timestamp
@@ -566,6 +596,33 @@ func (timestamp *UnixTimestamp) FromString(raw string) error {
 	return timestamp.CheckValid()
 }
 
+// ToDateTime converts the timestamp to an xs:dateTime value, which is an RFC 3339 timestamp in UTC
+func (timestamp *UnixTimestamp) ToDateTime() string {
+
+	// If the timestamp is nil then return an empty value
+	if timestamp == nil {
+		return ""
+	}
+
+	// Otherwise, format the timestamp with as many fractional digits as are needed
+	return timestamp.AsTime().Format(time.RFC3339Nano)
+}
+
+// FromDateTime creates a new timestamp from an xs:dateTime value, or from an RFC 3339 timestamp. If the
+// value has no time zone then it is assumed to be in UTC. If the value is not in either of these formats
+// then it will be parsed as a UNIX epoch value instead
+func (timestamp *UnixTimestamp) FromDateTime(raw string) error {
+	raw = strings.TrimSpace(raw)
+	for _, layout := range []string{time.RFC3339Nano, localDateTime} {
+		if parsed, err := time.Parse(layout, raw); err == nil {
+			*timestamp = *NewFromTime(parsed)
+			return timestamp.CheckValid()
+		}
+	}
+
+	return timestamp.FromString(raw)
+}
+
 // Helper function that checks if a given timestamp is valid
 func (x *UnixTimestamp) check() uint {
 	const minTimestamp = -62135596800  // Seconds between 1970-01-01T00:00:00Z and 0001-01-01T00:00:00Z, inclusive
@@ -663,7 +720,7 @@ func MinDuration(a *UnixDuration, b *UnixDuration, others ...*UnixDuration) *Uni
 	return result
 }
 
-// AsDuration converts x to a time.Duration, returning an error in the event of an overflow
+// AsDuration converts x to a time.Duration, returning a utils.RangeError in the event of an overflow
 func (x *UnixDuration) AsDuration() (time.Duration, error) {
 
 	// First, get the seconds and nanoseconds from the Unix duration
@@ -674,16 +731,24 @@ func (x *UnixDuration) AsDuration() (time.Duration, error) {
 	// then return an error as this represents an overflow/underflow error
 	duration := time.Duration(secs) * time.Second
 	if duration/time.Second != time.Duration(secs) {
-		return time.Duration(0), fmt.Errorf("Seconds count was malformed")
+		if secs < 0 {
+			bound := fmt.Sprint(math.MinInt64 / int64(time.Second))
+			return time.Duration(0), x.rangeError("duration seconds", bound, utils.ErrUnderflow)
+		}
+
+		bound := fmt.Sprint(math.MaxInt64 / int64(time.Second))
+		return time.Duration(0), x.rangeError("duration seconds", bound, utils.ErrOverflow)
 	}
 
 	// Now, add the nanoseconds to the duration; if the additional results in a duration of a different
 	// sign from the Unix duration then return an error
 	duration += time.Duration(nanos) * time.Nanosecond
 	if secs < 0 && nanos < 0 && duration > 0 {
-		return time.Duration(math.MinInt64), fmt.Errorf("Duration underflow")
+		duration = time.Duration(math.MinInt64)
+		return duration, x.rangeError("duration", duration.String(), utils.ErrUnderflow)
 	} else if secs > 0 && nanos > 0 && duration < 0 {
-		return time.Duration(math.MaxInt64), fmt.Errorf("Duration overflow")
+		duration = time.Duration(math.MaxInt64)
+		return duration, x.rangeError("duration", duration.String(), utils.ErrOverflow)
 	}
 
 	// Finally, return the duration
@@ -740,24 +805,41 @@ func (x *UnixDuration) IsValid() bool {
 }
 
 // CheckValid returns an error if the duration is invalid. In particular, it checks whether the value
-// is within the range of -10000 years to +10000 years inclusive. An error is reported for a nil Duration.
+// is within the range of -10000 years to +10000 years inclusive. An error wrapping utils.ErrNil is
+// reported for a nil Duration, and a utils.RangeError otherwise. The nanoseconds must have the same
+// sign as the seconds, so their bound is zero if the signs differ.
 func (x *UnixDuration) CheckValid() error {
 	switch x.check() {
 	case invalidNil:
-		return fmt.Errorf("invalid nil Duration")
+		return fmt.Errorf("%w Duration", utils.ErrNil)
 	case invalidUnderflow:
-		return fmt.Errorf("duration (%v, %v) exceeds -10000 years", x.Seconds, x.Nanoseconds)
+		return x.rangeError("duration", "-10000 years", utils.ErrUnderflow)
 	case invalidOverflow:
-		return fmt.Errorf("duration (%v, %v) exceeds +10000 years", x.Seconds, x.Nanoseconds)
+		return x.rangeError("duration", "+10000 years", utils.ErrOverflow)
 	case invalidNanosRange:
-		return fmt.Errorf("duration (%v, %v) has out-of-range nanos", x.Seconds, x.Nanoseconds)
+		if x.Nanoseconds < 0 {
+			return x.rangeError("duration nanos", "-999999999", utils.ErrUnderflow)
+		}
+
+		return x.rangeError("duration nanos", "999999999", utils.ErrOverflow)
 	case invalidNanosSign:
-		return fmt.Errorf("duration (%v, %v) has seconds and nanos with different signs", x.Seconds, x.Nanoseconds)
+		return &utils.SignError{Type: "duration", Value: fmt.Sprintf("%d, %d", x.GetSeconds(), x.GetNanoseconds()),
+			Parts: "seconds and nanos"}
 	default:
 		return nil
 	}
 }
 
+// Helper function that creates a range error for a duration
+func (x *UnixDuration) rangeError(kind string, bound string, err error) error {
+	return &utils.RangeError{
+		Type:  kind,
+		Value: fmt.Sprintf("%d, %d", x.GetSeconds(), x.GetNanoseconds()),
+		Bound: bound,
+		Err:   err,
+	}
+}
+
 // ToEpoch converts the timestamp to a UNIX epoch value
 func (duration *UnixDuration) ToEpoch() string {
 
@@ -785,20 +867,20 @@ func (duration *UnixDuration) FromString(raw string) error {
 		duration = nil
 		return nil
 	} else if len(raw) < 10 {
-		return fmt.Errorf("value (%s) was not long enough to be converted to a duration", raw)
+		return &utils.ParseError{Type: "UnixDuration", Input: raw, Position: len(raw), Err: utils.ErrTooShort}
 	}
 
 	// Next, attempt to parse the number of seconds to a 64-bit integer. If this fails then return an error
 	partition := len(raw) - 9
 	seconds, err := strconv.ParseInt(raw[:partition], 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to convert seconds part to integer, error: %v", err)
+		return &utils.ParseError{Type: "UnixDuration", Input: raw, Position: 0, Err: err}
 	}
 
 	// Now, attempt to parse the number of nanoseconds to a 32-bit integer. If this fails then return an error
 	nanos, err := strconv.ParseInt(raw[partition:], 10, 32)
 	if err != nil {
-		return fmt.Errorf("failed to convert nanoseconds part to integer, error: %v", err)
+		return &utils.ParseError{Type: "UnixDuration", Input: raw, Position: partition, Err: err}
 	}
 
 	// If the number of seconds is less than 0 then the number of nanoseconds must also be less than
@@ -814,6 +896,122 @@ func (duration *UnixDuration) FromString(raw string) error {
 	return duration.CheckValid()
 }
 
+// ToISO8601 converts the duration to an xs:duration value, such as PT1H30M or -P1DT0.5S. Only days, hours,
+// minutes and seconds are written because years and months do not have a fixed length
+func (duration *UnixDuration) ToISO8601() string {
+
+	// First, if the duration is nil then return an empty value
+	if duration == nil {
+		return ""
+	}
+
+	// Next, if the duration is negative then write the sign and work with its absolute value
+	seconds, nanos := duration.Seconds, int64(duration.Nanoseconds)
+	var builder strings.Builder
+	if seconds < 0 || nanos < 0 {
+		builder.WriteByte('-')
+		seconds, nanos = -seconds, -nanos
+	}
+
+	// Now, write the number of days; if there is nothing else to write then we're done. A zero duration
+	// must still contain at least one component, so write it as zero seconds
+	builder.WriteByte('P')
+	days, seconds := seconds/secondsInDay, seconds%secondsInDay
+	if days > 0 {
+		fmt.Fprintf(&builder, "%dD", days)
+	}
+
+	if seconds == 0 && nanos == 0 {
+		if days == 0 {
+			builder.WriteString("T0S")
+		}
+
+		return builder.String()
+	}
+
+	// Finally, write the hours, minutes and seconds, omitting any that are zero
+	builder.WriteByte('T')
+	if hours := seconds / secondsInHour; hours > 0 {
+		fmt.Fprintf(&builder, "%dH", hours)
+	}
+
+	if minutes := seconds % secondsInHour / secondsInMinute; minutes > 0 {
+		fmt.Fprintf(&builder, "%dM", minutes)
+	}
+
+	if seconds %= secondsInMinute; seconds > 0 || nanos > 0 {
+		builder.WriteString(strconv.FormatInt(seconds, 10))
+		if nanos > 0 {
+			builder.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+		}
+
+		builder.WriteByte('S')
+	}
+
+	return builder.String()
+}
+
+// FromISO8601 creates a new duration from an xs:duration value, such as PT1H30M or -P1DT0.5S. Years and
+// months are not supported, unless they are zero, because they do not have a fixed length. If the value
+// cannot be parsed then a utils.ParseError will be returned
+func (duration *UnixDuration) FromISO8601(raw string) error {
+
+	// First, check if the string is empty. If it is then we're probably looking at an empty duration
+	if raw == "" {
+		return nil
+	}
+
+	// Next, attempt to match the value against the format of an xs:duration. The value must contain at
+	// least one component and, if it contains a time separator, at least one time component
+	match := iso8601Duration.FindStringSubmatchIndex(raw)
+	if match == nil || strings.HasSuffix(raw, "P") || strings.HasSuffix(raw, "T") {
+		return &utils.ParseError{Type: "UnixDuration", Input: raw, Position: -1, Err: utils.ErrMalformed}
+	}
+
+	// Now, years and months have no fixed length so return an error if either of them are set
+	for group := 2; group <= 3; group++ {
+		start, end := match[2*group], match[2*group+1]
+		if start >= 0 && strings.Trim(raw[start:end], "0") != "" {
+			return &utils.ParseError{Type: "UnixDuration", Input: raw, Position: start, Err: errVariableLength}
+		}
+	}
+
+	// Add up the days, hours, minutes and seconds; if any of these are too large then return an error
+	var seconds int64
+	for i, unit := range []int64{secondsInDay, secondsInHour, secondsInMinute, 1} {
+		start, end := match[2*(i+4)], match[2*(i+4)+1]
+		if start < 0 {
+			continue
+		}
+
+		value, err := strconv.ParseInt(raw[start:end], 10, 64)
+		if err == nil && value > (math.MaxInt64-seconds)/unit {
+			err = utils.ErrOverflow
+		}
+
+		if err != nil {
+			return &utils.ParseError{Type: "UnixDuration", Input: raw, Position: start, Err: err}
+		}
+
+		seconds += value * unit
+	}
+
+	// Convert the fractional seconds to nanoseconds, padding them so that they have nine digits
+	var nanos int64
+	if start, end := match[16], match[17]; start >= 0 {
+		nanos, _ = strconv.ParseInt(raw[start:end]+strings.Repeat("0", 9-(end-start)), 10, 64)
+	}
+
+	// Finally, apply the sign to the duration and then check that it is valid; return any error
+	if match[2] >= 0 {
+		seconds, nanos = -seconds, -nanos
+	}
+
+	duration.Seconds = seconds
+	duration.Nanoseconds = int32(nanos)
+	return duration.CheckValid()
+}
+
 // Helper function that checks if a given duration is valid
 func (x *UnixDuration) check() uint {
 	const absDuration = 315576000000 // 10000yr * 365.25day/yr * 24hr/day * 60min/hr * 60sec/min
@@ -801,13 +801,13 @@ var _ = Describe("UnixTimestamp Extensions Tests", func() {
 		},
 		Entry("Timestamp is nil - False", nil, true, "invalid nil Timestamp"),
 		Entry("Seconds < Minimum Timestamp - False", NewUnixTimestamp(-62135596801, 983651350), true,
-			"timestamp (-62135596801, 983651350) before 0001-01-01"),
+			"timestamp (-62135596801, 983651350) is less than the minimum of 0001-01-01"),
 		Entry("Seconds > Maximum Timestamp - False", NewUnixTimestamp(253402300800, 983651350), true,
-			"timestamp (253402300800, 983651350) after 9999-12-31"),
+			"timestamp (253402300800, 983651350) is greater than the maximum of 9999-12-31"),
 		Entry("Nanoseconds > 1 second - False", NewUnixTimestamp(1654127993, 1000000000), true,
-			"timestamp (1654127993, 1000000000) has out-of-range nanos"),
+			"timestamp nanos (1654127993, 1000000000) is greater than the maximum of 999999999"),
 		Entry("Nanoseconds negative - False", NewUnixTimestamp(1654127993, -1), true,
-			"timestamp (1654127993, -1) has out-of-range nanos"),
+			"timestamp nanos (1654127993, -1) is less than the minimum of 0"),
 		Entry("Valid - True", NewUnixTimestamp(1654127993, 983651350), false, ""))
 
 	// Test that the ToDate function converts the UnixTimestamp to a string describing the date associated
@@ -816,6 +816,34 @@ var _ = Describe("UnixTimestamp Extensions Tests", func() {
 		stamp := NewUnixTimestamp(1654127993, 983651350)
 		Expect(stamp.ToDate()).Should(Equal("2022-06-01"))
 	})
+
+	// Test that the ToDateTime function converts the UnixTimestamp to an xs:dateTime value in UTC
+	It("ToDateTime - Works", func() {
+		Expect(NewUnixTimestamp(1654127993, 983651350).ToDateTime()).Should(Equal("2022-06-01T23:59:53.98365135Z"))
+		Expect(NewUnixTimestamp(1654127993, 0).ToDateTime()).Should(Equal("2022-06-01T23:59:53Z"))
+		Expect((*UnixTimestamp)(nil).ToDateTime()).Should(BeEmpty())
+	})
+
+	// Test the conditions under which an xs:dateTime value can be converted to a UnixTimestamp
+	DescribeTable("FromDateTime - Conditions",
+		func(raw string, expected *UnixTimestamp, message string) {
+			timestamp := new(UnixTimestamp)
+			err := timestamp.FromDateTime(raw)
+			if message != "" {
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).Should(Equal(message))
+			} else {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(timestamp).Should(Equal(expected))
+			}
+		},
+		Entry("UTC - Works", "2022-06-01T23:59:53.98365135Z", NewUnixTimestamp(1654127993, 983651350), ""),
+		Entry("Offset - Works", "2022-06-02T01:59:53+02:00", NewUnixTimestamp(1654127993, 0), ""),
+		Entry("No time zone - Works", "2022-06-01T23:59:53.5", NewUnixTimestamp(1654127993, 500000000), ""),
+		Entry("Epoch - Works", "1654127993983651350", NewUnixTimestamp(1654127993, 983651350), ""),
+		Entry("Invalid - Error", "2022-06-01Tderp", nil,
+			"value of \"2022-06-01Tderp\" could not be parsed as a UnixTimestamp at position 0: "+
+				"strconv.ParseInt: parsing \"2022-0\": invalid syntax"))
 })
 
 var _ = Describe("UnixDuration Extensions Tests", func() {
@@ -839,9 +867,9 @@ var _ = Describe("UnixDuration Extensions Tests", func() {
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).Should(Equal(message))
 		},
-		Entry("Seconds overflow - Error", NewUnixDuration(1<<60, 0), int64(0), "Seconds count was malformed"),
-		Entry("Underflow error - Error", NewUnixDuration(-9223372036, -1000000000), int64(math.MinInt64), "Duration underflow"),
-		Entry("Overflow error - Error", NewUnixDuration(9223372036, 1000000000), int64(math.MaxInt64), "Duration overflow"))
+		Entry("Seconds overflow - Error", NewUnixDuration(1<<60, 0), int64(0), "duration seconds (1152921504606846976, 0) is greater than the maximum of 9223372036"),
+		Entry("Underflow error - Error", NewUnixDuration(-9223372036, -1000000000), int64(math.MinInt64), "duration (-9223372036, -1000000000) is less than the minimum of -2562047h47m16.854775808s"),
+		Entry("Overflow error - Error", NewUnixDuration(9223372036, 1000000000), int64(math.MaxInt64), "duration (9223372036, 1000000000) is greater than the maximum of 2562047h47m16.854775807s"))
 
 	// Tests the data conditions determining what MaxDuration will return
 	DescribeTable("MaxDuration - Conditions",
@@ -1020,16 +1048,64 @@ var _ = Describe("UnixDuration Extensions Tests", func() {
 		},
 		Entry("Duration is nil - False", nil, true, "invalid nil Duration"),
 		Entry("Seconds < -10,000 years - False", NewUnixDuration(-315576000001, 0), true,
-			"duration (-315576000001, 0) exceeds -10000 years"),
+			"duration (-315576000001, 0) is less than the minimum of -10000 years"),
 		Entry("Seconds > 10,000 years - False", NewUnixDuration(315576000001, 0), true,
-			"duration (315576000001, 0) exceeds +10000 years"),
+			"duration (315576000001, 0) is greater than the maximum of +10000 years"),
 		Entry("Nanoseconds <= -1e9 - False", NewUnixDuration(2678400, -1000000000), true,
-			"duration (2678400, -1000000000) has out-of-range nanos"),
+			"duration nanos (2678400, -1000000000) is less than the minimum of -999999999"),
 		Entry("Nanoseconds >= 1e9 - False", NewUnixDuration(2678400, 1000000000), true,
-			"duration (2678400, 1000000000) has out-of-range nanos"),
+			"duration nanos (2678400, 1000000000) is greater than the maximum of 999999999"),
 		Entry("Seconds > 0, Nanoseconds < 0 - False", NewUnixDuration(2678400, -1000), true,
 			"duration (2678400, -1000) has seconds and nanos with different signs"),
 		Entry("Seconds < 0, Nanoseconds > 0 - False", NewUnixDuration(-2678400, 1000), true,
 			"duration (-2678400, 1000) has seconds and nanos with different signs"),
 		Entry("Valid - True", NewUnixDuration(2678400, 1000), false, ""))
+
+	// Test that the ToISO8601 function converts the UnixDuration to an xs:duration value
+	DescribeTable("ToISO8601 - Works",
+		func(duration *UnixDuration, expected string) {
+			Expect(duration.ToISO8601()).Should(Equal(expected))
+		},
+		Entry("Nil - Empty", nil, ""),
+		Entry("Zero - Works", NewUnixDuration(0, 0), "PT0S"),
+		Entry("Days only - Works", NewUnixDuration(2*secondsInDay, 0), "P2D"),
+		Entry("Hours and minutes - Works", NewUnixDuration(5400, 0), "PT1H30M"),
+		Entry("Fractional seconds - Works", NewUnixDuration(2678400, 15000000), "P31DT0.015S"),
+		Entry("All components - Works", NewUnixDuration(90061, 500000000), "P1DT1H1M1.5S"),
+		Entry("Negative - Works", NewUnixDuration(-1, -500000000), "-PT1.5S"),
+		Entry("Negative nanoseconds only - Works", NewUnixDuration(0, -1), "-PT0.000000001S"))
+
+	// Test the conditions under which an xs:duration value can be converted to a UnixDuration
+	DescribeTable("FromISO8601 - Conditions",
+		func(raw string, expected *UnixDuration, message string) {
+			duration := new(UnixDuration)
+			err := duration.FromISO8601(raw)
+			if message != "" {
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).Should(Equal(message))
+			} else {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(duration).Should(Equal(expected))
+			}
+		},
+		Entry("Zero - Works", "PT0S", NewUnixDuration(0, 0), ""),
+		Entry("Days only - Works", "P2D", NewUnixDuration(2*secondsInDay, 0), ""),
+		Entry("Hours and minutes - Works", "PT1H30M", NewUnixDuration(5400, 0), ""),
+		Entry("All components - Works", "P1DT1H1M1.5S", NewUnixDuration(90061, 500000000), ""),
+		Entry("Zero years and months - Works", "P0Y0M1D", NewUnixDuration(secondsInDay, 0), ""),
+		Entry("Negative - Works", "-PT1.5S", NewUnixDuration(-1, -500000000), ""),
+		Entry("Unnormalized - Works", "PT90M", NewUnixDuration(5400, 0), ""),
+		Entry("No components - Error", "P", nil,
+			"value of \"P\" could not be parsed as a UnixDuration: malformed input"),
+		Entry("No time components - Error", "P1DT", nil,
+			"value of \"P1DT\" could not be parsed as a UnixDuration: malformed input"),
+		Entry("Not a duration - Error", "1h30m", nil,
+			"value of \"1h30m\" could not be parsed as a UnixDuration: malformed input"),
+		Entry("Months - Error", "P1M", nil,
+			"value of \"P1M\" could not be parsed as a UnixDuration at position 1: "+
+				"years and months do not have a fixed length"),
+		Entry("Overflow - Error", "P99999999999999999D", nil,
+			"value of \"P99999999999999999D\" could not be parsed as a UnixDuration at position 1: value overflow"),
+		Entry("Out of range - Error", "P4000000D", nil,
+			"duration (345600000000, 0) is greater than the maximum of +10000 years"))
 })
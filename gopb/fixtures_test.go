@@ -0,0 +1,37 @@
+package gopb
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// The time at which the first trade, quote or indicator we'll use for testing occurs (2022-06-01 14:30:00
+// UTC, which is 10:30:00 EDT)
+const base = int64(1654093800)
+
+// Helper function that converts a string to a Decimal, returning nil if the string is empty
+func dec(raw string) *Decimal {
+	if raw == "" {
+		return nil
+	}
+
+	return NewFromDecimal(decimal.RequireFromString(raw))
+}
+
+// Helper function that creates a UnixTimestamp at midnight UTC on a year, month and day
+func date(year int, month time.Month, day int) *UnixTimestamp {
+	return NewFromTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// Helper function that creates a trade on tape C from an offset from the base time, price, size and
+// conditions
+func trade(offset time.Duration, price string, size string, conds ...Financial_Trades_Condition) *Trade {
+	return &Trade{
+		Timestamp:  NewUnixTimestamp(base, 0).AddDuration(NewFromDuration(offset)),
+		Price:      dec(price),
+		Size:       dec(size),
+		Conditions: conds,
+		Tape:       Financial_Common_C,
+	}
+}
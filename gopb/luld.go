@@ -0,0 +1,195 @@
+package gopb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// LULDTier describes the tier of an NMS stock under the Limit Up-Limit Down plan
+type LULDTier int
+
+const (
+	LULDNotCovered LULDTier = iota // The security is not covered by the LULD plan
+	LULDTier1                      // The security is in the S&P 500, Russell 1000 or is a select ETP
+	LULDTier2                      // The security is any other NMS stock
+)
+
+// Price levels, percentages and time windows used to calculate LULD price bands
+var (
+	luldHighPriceLevel = decimal.NewFromInt(3)
+	luldLowPriceLevel  = decimal.RequireFromString("0.75")
+	luldTier1Percent   = decimal.RequireFromString("0.05")
+	luldTier2Percent   = decimal.RequireFromString("0.10")
+	luldMidPercent     = decimal.RequireFromString("0.20")
+	luldLowPercent     = decimal.RequireFromString("0.75")
+	luldLowAmount      = decimal.RequireFromString("0.15")
+	luldMarketOpen     = 9*time.Hour + 30*time.Minute
+	luldOpenDoubled    = 9*time.Hour + 45*time.Minute
+	luldCloseDoubled   = 15*time.Hour + 35*time.Minute
+	luldMarketClose    = 16 * time.Hour
+)
+
+// NewLULDTier determines the LULD tier of a security from its asset type and whether or not it is
+// listed in one of the Tier 1 lists (the S&P 500, the Russell 1000 or the list of select ETPs). Bonds,
+// indices, baskets, rights and warrants are not covered by the LULD plan
+func NewLULDTier(assetType Financial_Common_AssetType, tier1Listed bool) LULDTier {
+	switch assetType {
+	case Financial_Common_CorporateBond, Financial_Common_AgencyBond, Financial_Common_EquityLinkedBond,
+		Financial_Common_Index, Financial_Common_Basket, Financial_Common_Rights, Financial_Common_Warrant,
+		Financial_Common_AmericanDepositoryReceiptRights, Financial_Common_AmericanDepositoryReceiptWarrants,
+		Financial_Common_None:
+		return LULDNotCovered
+	}
+
+	if tier1Listed {
+		return LULDTier1
+	}
+
+	return LULDTier2
+}
+
+// LULDBands describes the LULD price bands calculated from a reference price
+type LULDBands struct {
+	Reference *Decimal
+	Lower     *Decimal
+	Upper     *Decimal
+	Doubled   bool
+}
+
+// LULDCalculator calculates LULD price bands for securities. Since the width of the bands depends on
+// the time of day, the calculator requires the location in which market hours are defined
+type LULDCalculator struct {
+	location *time.Location
+}
+
+// NewLULDCalculator creates a new LULDCalculator that determines the time of day in the location provided,
+// which should be the location of the US equity markets (America/New_York)
+func NewLULDCalculator(location *time.Location) *LULDCalculator {
+	return &LULDCalculator{location: location}
+}
+
+// InRuleHours returns whether or not LULD price bands apply at the timestamp provided. Price bands
+// only apply during regular trading hours (09:30 to 16:00)
+func (calc *LULDCalculator) InRuleHours(timestamp *UnixTimestamp) bool {
+	offset := calc.timeOfDay(timestamp)
+	return offset >= luldMarketOpen && offset < luldMarketClose
+}
+
+// Bands calculates the LULD price bands for a security of the tier provided from its reference price at
+// the timestamp provided. The percentage used is determined by the tier and the reference price and is
+// doubled during the first fifteen minutes of regular trading hours and, for Tier 2 securities with a
+// reference price of $3.00 or less, during the last twenty-five minutes of regular trading hours. Bands
+// are rounded to the nearest penny and the lower band will never be negative. A utils.RangeError will
+// be returned if the security is not covered by LULD, if the reference price is not positive or if the
+// timestamp is outside of regular trading hours, and an error wrapping utils.ErrNil will be returned if
+// the reference price is nil
+func (calc *LULDCalculator) Bands(reference *Decimal, tier LULDTier, timestamp *UnixTimestamp) (*LULDBands, error) {
+
+	// First, verify that bands can be calculated for the inputs
+	offset := calc.timeOfDay(timestamp)
+	switch {
+	case tier < LULDTier1:
+		return nil, &utils.RangeError{Type: "LULD tier", Value: strconv.Itoa(int(tier)),
+			Bound: strconv.Itoa(int(LULDTier1)), Err: utils.ErrUnderflow}
+	case tier > LULDTier2:
+		return nil, &utils.RangeError{Type: "LULD tier", Value: strconv.Itoa(int(tier)),
+			Bound: strconv.Itoa(int(LULDTier2)), Err: utils.ErrOverflow}
+	case reference == nil:
+		return nil, fmt.Errorf("%w LULD reference price", utils.ErrNil)
+	case !reference.ToDecimal().IsPositive():
+		return nil, &utils.RangeError{Type: "LULD reference price", Value: reference.ToString(), Bound: "0 (exclusive)",
+			Err: utils.ErrUnderflow}
+	case offset < luldMarketOpen:
+		return nil, &utils.RangeError{Type: "LULD time of day", Value: clockTime(offset),
+			Bound: clockTime(luldMarketOpen), Err: utils.ErrUnderflow}
+	case offset >= luldMarketClose:
+		return nil, &utils.RangeError{Type: "LULD time of day", Value: clockTime(offset),
+			Bound: clockTime(luldMarketClose - time.Nanosecond), Err: utils.ErrOverflow}
+	}
+
+	// Next, determine the width of the bands from the tier and price level of the security
+	price := *reference.ToDecimal()
+	var width decimal.Decimal
+	switch {
+	case price.GreaterThan(luldHighPriceLevel) && tier == LULDTier1:
+		width = price.Mul(luldTier1Percent)
+	case price.GreaterThan(luldHighPriceLevel):
+		width = price.Mul(luldTier2Percent)
+	case price.GreaterThanOrEqual(luldLowPriceLevel):
+		width = price.Mul(luldMidPercent)
+	default:
+		width = decimal.Min(luldLowAmount, price.Mul(luldLowPercent))
+	}
+
+	// Now, double the width of the bands if we're near the open or, for low-priced Tier 2 securities,
+	// if we're near the close
+	doubled := offset < luldOpenDoubled || (offset >= luldCloseDoubled && tier == LULDTier2 &&
+		price.LessThanOrEqual(luldHighPriceLevel))
+	if doubled {
+		width = width.Mul(decimal.NewFromInt(2))
+	}
+
+	// Finally, calculate the bands, rounding them to the nearest penny and preventing the lower band
+	// from going negative, and return them
+	lower := decimal.Max(price.Sub(width).Round(2), decimal.Zero)
+	return &LULDBands{
+		Reference: NewFromDecimal(price),
+		Lower:     NewFromDecimal(lower),
+		Upper:     NewFromDecimal(price.Add(width).Round(2)),
+		Doubled:   doubled,
+	}, nil
+}
+
+// Classify determines the LULD indicator associated with the national best bid and offer provided,
+// relative to these bands. If either side of the quote is missing then it will not be considered
+func (bands *LULDBands) Classify(bid *Decimal, ask *Decimal) Financial_Quotes_Indicator {
+	lower, upper := bands.Lower.ToDecimal(), bands.Upper.ToDecimal()
+
+	// First, determine where the NBB and NBO sit relative to the bands
+	var bidBelow, bidAtUpper, askAbove, askAtLower bool
+	if bid != nil {
+		value := bid.ToDecimal()
+		bidBelow, bidAtUpper = value.LessThan(*lower), value.Equal(*upper)
+	}
+
+	if ask != nil {
+		value := ask.ToDecimal()
+		askAbove, askAtLower = value.GreaterThan(*upper), value.Equal(*lower)
+	}
+
+	// Next, convert the combination of positions to an indicator
+	switch {
+	case bidBelow && askAbove:
+		return Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand
+	case bidBelow && askAtLower:
+		return Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand
+	case bidAtUpper && askAbove:
+		return Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand
+	case bidBelow:
+		return Financial_Quotes_NBBBelowLowerBand
+	case askAbove:
+		return Financial_Quotes_NBOAboveUpperBand
+	case bidAtUpper:
+		return Financial_Quotes_NBBEqualsUpperBand
+	case askAtLower:
+		return Financial_Quotes_NBOEqualsLowerBand
+	default:
+		return Financial_Quotes_NBBNBOExecutable
+	}
+}
+
+// Helper function that returns the time of day of the timestamp in the calculator's location
+func (calc *LULDCalculator) timeOfDay(timestamp *UnixTimestamp) time.Duration {
+	t := timestamp.AsTime().In(calc.location)
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+}
+
+// Helper function that formats a time of day as a clock time, such as 09:30:00
+func clockTime(offset time.Duration) string {
+	return time.Time{}.Add(offset).Format("15:04:05.999999999")
+}
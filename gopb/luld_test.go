@@ -0,0 +1,109 @@
+package gopb
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("LULD Tests", func() {
+
+	// The location of the market, in which the base time is 10:30:00
+	location := time.FixedZone("EDT", -4*60*60)
+
+	// Tests that the NewLULDTier function determines the tier of a security correctly
+	DescribeTable("NewLULDTier - Works",
+		func(assetType Financial_Common_AssetType, listed bool, expected LULDTier) {
+			Expect(NewLULDTier(assetType, listed)).Should(Equal(expected))
+		},
+		Entry("Common Share, Listed - Tier 1", Financial_Common_CommonShare, true, LULDTier1),
+		Entry("Common Share, Not Listed - Tier 2", Financial_Common_CommonShare, false, LULDTier2),
+		Entry("ETF, Listed - Tier 1", Financial_Common_ExchangeTradedFund, true, LULDTier1),
+		Entry("Corporate Bond - Not covered", Financial_Common_CorporateBond, false, LULDNotCovered),
+		Entry("Index - Not covered", Financial_Common_Index, true, LULDNotCovered),
+		Entry("Rights - Not covered", Financial_Common_Rights, false, LULDNotCovered),
+		Entry("Warrant - Not covered", Financial_Common_Warrant, true, LULDNotCovered),
+		Entry("ADR Rights - Not covered", Financial_Common_AmericanDepositoryReceiptRights, false, LULDNotCovered),
+		Entry("ADR Warrants - Not covered", Financial_Common_AmericanDepositoryReceiptWarrants, true,
+			LULDNotCovered))
+
+	// Tests that the InRuleHours function determines whether price bands apply correctly
+	DescribeTable("InRuleHours - Works",
+		func(offset int64, expected bool) {
+			calc := NewLULDCalculator(location)
+			Expect(calc.InRuleHours(NewUnixTimestamp(base+offset, 0))).Should(Equal(expected))
+		},
+		Entry("Before open - False", int64(-3601), false),
+		Entry("At open - True", int64(-3600), true),
+		Entry("Mid-day - True", int64(0), true),
+		Entry("Just before close - True", int64(19799), true),
+		Entry("At close - False", int64(19800), false))
+
+	// Tests that the Bands function calculates the bands correctly
+	DescribeTable("Bands - Works",
+		func(reference string, tier LULDTier, offset int64, lower string, upper string, doubled bool) {
+			calc := NewLULDCalculator(location)
+			bands, err := calc.Bands(dec(reference), tier, NewUnixTimestamp(base+offset, 0))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bands.Reference.ToString()).Should(Equal(reference))
+			Expect(bands.Lower.ToString()).Should(Equal(lower))
+			Expect(bands.Upper.ToString()).Should(Equal(upper))
+			Expect(bands.Doubled).Should(Equal(doubled))
+		},
+		Entry("Tier 1, above $3.00 - Works", "100", LULDTier1, int64(0), "95", "105", false),
+		Entry("Tier 2, above $3.00 - Works", "100", LULDTier2, int64(0), "90", "110", false),
+		Entry("Tier 1, above $3.00, near open - Doubled", "100", LULDTier1, int64(-3300), "90", "110", true),
+		Entry("Tier 2, above $3.00, near open - Doubled", "100", LULDTier2, int64(-3300), "80", "120", true),
+		Entry("Tier 2, above $3.00, near close - Not doubled", "100", LULDTier2, int64(18600), "90", "110", false),
+		Entry("Tier 2, at $3.00, near close - Doubled", "3", LULDTier2, int64(18600), "1.8", "4.2", true),
+		Entry("Tier 1, below $3.00, near close - Not doubled", "2", LULDTier1, int64(18600), "1.6", "2.4", false),
+		Entry("Tier 2, between $0.75 and $3.00 - Works", "2", LULDTier2, int64(0), "1.6", "2.4", false),
+		Entry("Tier 1, below $0.75 - Lesser of $0.15", "0.5", LULDTier1, int64(0), "0.35", "0.65", false),
+		Entry("Tier 1, below $0.75 - Lesser of 75%", "0.1", LULDTier1, int64(0), "0.03", "0.18", false),
+		Entry("Tier 2, below $0.75, near close - Lower band floored", "0.1", LULDTier2, int64(19200),
+			"0", "0.25", true))
+
+	// Tests that the Bands function returns an error if the bands cannot be calculated
+	DescribeTable("Bands - Failures",
+		func(reference *Decimal, tier LULDTier, offset int64, inner error, message string) {
+			calc := NewLULDCalculator(location)
+			bands, err := calc.Bands(reference, tier, NewUnixTimestamp(base+offset, 0))
+			Expect(err).Should(HaveOccurred())
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal(message))
+			Expect(bands).Should(BeNil())
+		},
+		Entry("Not covered - Error", dec("100"), LULDNotCovered, int64(0), utils.ErrUnderflow,
+			"LULD tier (0) is less than the minimum of 1"),
+		Entry("Unknown tier - Error", dec("100"), LULDTier(3), int64(0), utils.ErrOverflow,
+			"LULD tier (3) is greater than the maximum of 2"),
+		Entry("Nil reference - Error", nil, LULDTier1, int64(0), utils.ErrNil, "invalid nil LULD reference price"),
+		Entry("Zero reference - Error", dec("0"), LULDTier1, int64(0), utils.ErrUnderflow,
+			"LULD reference price (0) is less than the minimum of 0 (exclusive)"),
+		Entry("Before rule hours - Error", dec("100"), LULDTier1, int64(-9000), utils.ErrUnderflow,
+			"LULD time of day (08:00:00) is less than the minimum of 09:30:00"),
+		Entry("After rule hours - Error", dec("100"), LULDTier1, int64(19800), utils.ErrOverflow,
+			"LULD time of day (16:00:00) is greater than the maximum of 15:59:59.999999999"))
+
+	// Tests that the Classify function returns the correct indicator for various quotes
+	DescribeTable("Classify - Works",
+		func(bid *Decimal, ask *Decimal, expected Financial_Quotes_Indicator) {
+			bands := LULDBands{Reference: dec("100"), Lower: dec("95"), Upper: dec("105")}
+			Expect(bands.Classify(bid, ask)).Should(Equal(expected))
+		},
+		Entry("Within bands - Executable", dec("99.99"), dec("100.01"), Financial_Quotes_NBBNBOExecutable),
+		Entry("No quote - Executable", nil, nil, Financial_Quotes_NBBNBOExecutable),
+		Entry("Bid below lower - Works", dec("94.99"), dec("100"), Financial_Quotes_NBBBelowLowerBand),
+		Entry("Ask above upper - Works", dec("100"), dec("105.01"), Financial_Quotes_NBOAboveUpperBand),
+		Entry("Bid below lower, ask above upper - Works", dec("94"), dec("106"),
+			Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand),
+		Entry("Bid equals upper - Works", dec("105"), nil, Financial_Quotes_NBBEqualsUpperBand),
+		Entry("Ask equals lower - Works", nil, dec("95"), Financial_Quotes_NBOEqualsLowerBand),
+		Entry("Bid equals upper, ask above upper - Works", dec("105"), dec("105.5"),
+			Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand),
+		Entry("Bid below lower, ask equals lower - Works", dec("94.5"), dec("95"),
+			Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand))
+})
@@ -0,0 +1,68 @@
+package gopb
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Enum Metadata Tests", func() {
+
+	// Tests that Values and Names return every value of an enum in the order they were declared
+	It("Values, Names - Works", func() {
+		Expect(utils.Values[Financial_Common_Tape]()).Should(Equal(
+			[]Financial_Common_Tape{Financial_Common_A, Financial_Common_B, Financial_Common_C}))
+		Expect(utils.Names[Financial_Common_Tape]()).Should(Equal([]string{"A", "B", "C"}))
+		Expect(utils.Values[Financial_Trades_Condition]()).Should(HaveLen(len(Financial_Trades_Condition_name)))
+	})
+
+	// Tests that Describe returns the names, aliases and description associated with a value
+	It("Describe - Works", func() {
+		Expect(utils.Describe(Financial_Quotes_RegularTwoSidedOpen)).Should(Equal(utils.EnumValue[Financial_Quotes_Condition]{
+			Value:       Financial_Quotes_RegularTwoSidedOpen,
+			Name:        "RegularTwoSidedOpen",
+			OutputName:  "Regular, Two-Sided Open",
+			Aliases:     []string{"Regular, Two-Sided Open"},
+			Description: "Regular Two-Sided Open",
+		}))
+
+		Expect(utils.Describe(Financial_Common_B)).Should(Equal(utils.EnumValue[Financial_Common_Tape]{
+			Value:       Financial_Common_B,
+			Name:        "B",
+			OutputName:  "B",
+			Description: "NYSE ARCA / NYSE American",
+		}))
+	})
+
+	// Tests that modifying the aliases returned by Describe does not affect later calls
+	It("Describe - Aliases copied - Works", func() {
+		described := utils.Describe(Financial_Common_OverTheCounter)
+		Expect(described.Aliases).ShouldNot(BeEmpty())
+		expected := append([]string(nil), described.Aliases...)
+
+		described.Aliases[0] = "modified"
+		Expect(utils.Describe(Financial_Common_OverTheCounter).Aliases).Should(Equal(expected))
+	})
+
+	// Tests that Describe returns only the value and output name for a value that isn't defined
+	It("Describe - Undefined value - Works", func() {
+		Expect(utils.Describe(Financial_Common_Tape(57))).Should(Equal(utils.EnumValue[Financial_Common_Tape]{
+			Value:      Financial_Common_Tape(57),
+			OutputName: "57",
+		}))
+	})
+
+	// Tests that Enumerate describes every value, and that every alias decodes to the value it describes
+	It("Enumerate - Aliases decode to their values - Works", func() {
+		described := utils.Enumerate[Financial_Trades_Condition]()
+		Expect(described).Should(HaveLen(len(Financial_Trades_Condition_name)))
+		for _, value := range described {
+			Expect(value.Name).ShouldNot(BeEmpty())
+			for _, alias := range value.Aliases {
+				var decoded Financial_Trades_Condition
+				Expect(decoded.UnmarshalCSV(alias)).ShouldNot(HaveOccurred())
+				Expect(decoded).Should(Equal(value.Value), "alias %q", alias)
+			}
+		}
+	})
+})
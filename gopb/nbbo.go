@@ -0,0 +1,253 @@
+package gopb
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Limits on the prices and sizes that can be disseminated in a short-form NBBO appendage. Prices are
+// sent with a denominator of 100 and sizes are sent in round lots, both as unsigned 16-bit integers
+var (
+	shortAppendageMaxPrice = decimal.RequireFromString("655.35")
+	shortAppendageMaxSize  = decimal.NewFromInt(65535 * 100)
+)
+
+// Quote describes a single quote as it would be reported by a venue
+type Quote struct {
+	Exchange  int32
+	Timestamp *UnixTimestamp
+	BidPrice  *Decimal
+	BidSize   *Decimal
+	AskPrice  *Decimal
+	AskSize   *Decimal
+	Condition Financial_Quotes_Condition
+	Tape      Financial_Common_Tape
+}
+
+// NBBO describes the national best bid and offer. The exchange, price and size on each side will be
+// taken from the venue with the best price and, of those venues at the best price, the one with the
+// largest size, followed by the one that quoted the price first. If there is no eligible quote on a
+// side then the price and size on that side will be nil. The condition will be set to LockedMarket or
+// CrossedMarket if the market is locked or crossed and the indicator will contain the NBBO appendage
+// indicator associated with the quote that produced the NBBO
+type NBBO struct {
+	Timestamp   *UnixTimestamp
+	BidExchange int32
+	BidPrice    *Decimal
+	BidSize     *Decimal
+	AskExchange int32
+	AskPrice    *Decimal
+	AskSize     *Decimal
+	Condition   Financial_Quotes_Condition
+	Indicator   Financial_Quotes_Indicator
+}
+
+// NBBOBuilder constructs the national best bid and offer from the quotes reported by individual venues.
+// Each venue's latest quote replaces its previous quote and sides of the quote which are not eligible
+// to be included in the NBBO, according to the quote condition table, are dropped
+type NBBOBuilder struct {
+	venues  map[int32]*venueQuote
+	current *NBBO
+}
+
+// Helper type that contains the eligible sides of a venue's latest quote
+type venueQuote struct {
+	exchange int32
+	bid      *quoteSide
+	ask      *quoteSide
+}
+
+// Helper type that contains a single side of a venue's quote
+type quoteSide struct {
+	price decimal.Decimal
+	size  decimal.Decimal
+	since *UnixTimestamp
+}
+
+// NewNBBOBuilder creates a new NBBOBuilder with no quotes
+func NewNBBOBuilder() *NBBOBuilder {
+	return &NBBOBuilder{venues: make(map[int32]*venueQuote)}
+}
+
+// Current returns the current NBBO, or nil if no quotes have been received
+func (builder *NBBOBuilder) Current() *NBBO {
+	return builder.current
+}
+
+// Update updates the NBBO with the latest quote from a venue and returns the resulting NBBO. If the quote
+// did not change the NBBO then the indicator on the result will be NBBONoChange. Otherwise, it will be
+// NBBOQuoteIsNBBO if the quote itself is the NBBO, NBBONoBBNoBO if there is no national best bid or
+// offer and a short or long NBBO appendage, depending on whether the NBBO would fit in a short-form
+// appendage
+func (builder *NBBOBuilder) Update(quote *Quote) *NBBO {
+
+	// First, replace the venue's previous quote with the sides of this quote that are eligible
+	previous := builder.venues[quote.Exchange]
+	venue := venueQuote{exchange: quote.Exchange}
+	rule := QuoteConditionRules(quote.Condition, quote.Tape)
+	if rule.BidEligible {
+		venue.bid = newQuoteSide(quote.BidPrice, quote.BidSize, quote.Timestamp, previous.bidSide())
+	}
+
+	if rule.AskEligible {
+		venue.ask = newQuoteSide(quote.AskPrice, quote.AskSize, quote.Timestamp, previous.askSide())
+	}
+
+	builder.venues[quote.Exchange] = &venue
+
+	// Next, calculate the new NBBO from the quotes of all the venues
+	next := builder.calculate(quote.Timestamp)
+
+	// Now, determine which appendage indicator should be associated with the NBBO
+	switch {
+	case builder.current != nil && next.sameAs(builder.current):
+		next.Indicator = Financial_Quotes_NBBONoChange
+	case next.BidPrice == nil && next.AskPrice == nil:
+		next.Indicator = Financial_Quotes_NBBONoBBNoBO
+	case next.BidPrice != nil && next.AskPrice != nil && next.BidExchange == quote.Exchange &&
+		next.AskExchange == quote.Exchange:
+		next.Indicator = Financial_Quotes_NBBOQuoteIsNBBO
+	case next.fitsShortAppendage():
+		next.Indicator = Financial_Quotes_NBBOBBBOShortAppendage
+	default:
+		next.Indicator = Financial_Quotes_NBBOBBBOLongAppendage
+	}
+
+	// Finally, if the NBBO changed then save it; either way, return it
+	if next.Indicator != Financial_Quotes_NBBONoChange {
+		builder.current = next
+	}
+
+	return next
+}
+
+// Helper function that calculates the NBBO from the quotes of all the venues
+func (builder *NBBOBuilder) calculate(timestamp *UnixTimestamp) *NBBO {
+	nbbo := NBBO{Timestamp: timestamp, Condition: Financial_Quotes_Regular}
+
+	// First, find the best side on each side of the market from all the venues
+	var bestBid, bestAsk *quoteSide
+	for _, venue := range builder.venues {
+		if venue.bid != nil && betterSide(venue.bid, bestBid, venue.exchange, nbbo.BidExchange, true) {
+			bestBid, nbbo.BidExchange = venue.bid, venue.exchange
+		}
+
+		if venue.ask != nil && betterSide(venue.ask, bestAsk, venue.exchange, nbbo.AskExchange, false) {
+			bestAsk, nbbo.AskExchange = venue.ask, venue.exchange
+		}
+	}
+
+	// Next, set the prices and sizes on each side of the NBBO
+	if bestBid != nil {
+		nbbo.BidPrice, nbbo.BidSize = NewFromDecimal(bestBid.price), NewFromDecimal(bestBid.size)
+	}
+
+	if bestAsk != nil {
+		nbbo.AskPrice, nbbo.AskSize = NewFromDecimal(bestAsk.price), NewFromDecimal(bestAsk.size)
+	}
+
+	// Finally, determine whether the market is locked or crossed
+	if bestBid != nil && bestAsk != nil {
+		if bestBid.price.Equal(bestAsk.price) {
+			nbbo.Condition = Financial_Quotes_LockedMarket
+		} else if bestBid.price.GreaterThan(bestAsk.price) {
+			nbbo.Condition = Financial_Quotes_CrossedMarket
+		}
+	}
+
+	return &nbbo
+}
+
+// Helper function that determines whether or not a side is better than the current best side. Higher
+// prices are better for bids and lower prices are better for asks. Ties are broken by size, then by the
+// time at which the price was first quoted and finally by exchange so that the result is deterministic
+func betterSide(candidate *quoteSide, best *quoteSide, exchange int32, bestExchange int32, isBid bool) bool {
+	switch {
+	case best == nil:
+		return true
+	case !candidate.price.Equal(best.price):
+		return candidate.price.GreaterThan(best.price) == isBid
+	case !candidate.size.Equal(best.size):
+		return candidate.size.GreaterThan(best.size)
+	case candidate.since.NotEquals(best.since):
+		return candidate.since.LessThan(best.since)
+	default:
+		return exchange < bestExchange
+	}
+}
+
+// Helper function that creates a new side from a price and size. If the price or size are missing or
+// not positive then nil will be returned. If the previous side had the same price then the time at
+// which the price was first quoted will be carried forward
+func newQuoteSide(price *Decimal, size *Decimal, timestamp *UnixTimestamp, previous *quoteSide) *quoteSide {
+	if price == nil || size == nil {
+		return nil
+	}
+
+	s := quoteSide{price: *price.ToDecimal(), size: *size.ToDecimal(), since: timestamp}
+	if !s.price.IsPositive() || !s.size.IsPositive() {
+		return nil
+	}
+
+	if previous != nil && previous.price.Equal(s.price) {
+		s.since = previous.since
+	}
+
+	return &s
+}
+
+// Helper function that returns the bid side of a venue's quote, or nil if there is no quote
+func (venue *venueQuote) bidSide() *quoteSide {
+	if venue == nil {
+		return nil
+	}
+
+	return venue.bid
+}
+
+// Helper function that returns the ask side of a venue's quote, or nil if there is no quote
+func (venue *venueQuote) askSide() *quoteSide {
+	if venue == nil {
+		return nil
+	}
+
+	return venue.ask
+}
+
+// Helper function that determines whether or not two NBBOs have the same exchanges, prices and sizes
+func (nbbo *NBBO) sameAs(other *NBBO) bool {
+	return nbbo.BidExchange == other.BidExchange && nbbo.AskExchange == other.AskExchange &&
+		decimalsEqual(nbbo.BidPrice, other.BidPrice) && decimalsEqual(nbbo.BidSize, other.BidSize) &&
+		decimalsEqual(nbbo.AskPrice, other.AskPrice) && decimalsEqual(nbbo.AskSize, other.AskSize)
+}
+
+// Helper function that determines whether or not the NBBO can be disseminated in a short-form appendage
+func (nbbo *NBBO) fitsShortAppendage() bool {
+	for _, price := range []*Decimal{nbbo.BidPrice, nbbo.AskPrice} {
+		if price != nil {
+			value := price.ToDecimal()
+			if value.GreaterThan(shortAppendageMaxPrice) || !value.Mul(decimal.NewFromInt(100)).IsInteger() {
+				return false
+			}
+		}
+	}
+
+	for _, size := range []*Decimal{nbbo.BidSize, nbbo.AskSize} {
+		if size != nil {
+			value := size.ToDecimal()
+			if value.GreaterThan(shortAppendageMaxSize) || !value.Mod(decimal.NewFromInt(100)).IsZero() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Helper function that determines whether or not two, possibly nil, decimals are equal
+func decimalsEqual(a *Decimal, b *Decimal) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.ToDecimal().Equal(*b.ToDecimal())
+}
@@ -0,0 +1,121 @@
+package gopb
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NBBO Tests", func() {
+
+	// Helper function that creates a quote from an exchange, offset, bid, ask and condition
+	quote := func(exchange int32, offset int64, bid string, bidSize string, ask string, askSize string,
+		cond Financial_Quotes_Condition) *Quote {
+		return &Quote{
+			Exchange:  exchange,
+			Timestamp: NewUnixTimestamp(base+offset, 0),
+			BidPrice:  dec(bid),
+			BidSize:   dec(bidSize),
+			AskPrice:  dec(ask),
+			AskSize:   dec(askSize),
+			Condition: cond,
+			Tape:      Financial_Common_C,
+		}
+	}
+
+	// Helper function that verifies the fields on an NBBO
+	verifyNBBO := func(nbbo *NBBO, bidExchange int32, bid string, bidSize string, askExchange int32, ask string,
+		askSize string, cond Financial_Quotes_Condition, indicator Financial_Quotes_Indicator) {
+		Expect(nbbo.BidExchange).Should(Equal(bidExchange))
+		Expect(nbbo.BidPrice.ToString()).Should(Equal(bid))
+		Expect(nbbo.BidSize.ToString()).Should(Equal(bidSize))
+		Expect(nbbo.AskExchange).Should(Equal(askExchange))
+		Expect(nbbo.AskPrice.ToString()).Should(Equal(ask))
+		Expect(nbbo.AskSize.ToString()).Should(Equal(askSize))
+		Expect(nbbo.Condition).Should(Equal(cond))
+		Expect(nbbo.Indicator).Should(Equal(indicator))
+	}
+
+	// Tests that the Update function maintains the NBBO as quotes are received from several venues
+	It("Update - Works", func() {
+		builder := NewNBBOBuilder()
+		Expect(builder.Current()).Should(BeNil())
+
+		// First, add a quote from a single venue; this quote should be the NBBO
+		nbbo := builder.Update(quote(1, 0, "10", "100", "10.05", "200", Financial_Quotes_Regular))
+		verifyNBBO(nbbo, 1, "10", "100", 1, "10.05", "200",
+			Financial_Quotes_Regular, Financial_Quotes_NBBOQuoteIsNBBO)
+
+		// Next, add a quote from a second venue that improves the bid; the NBBO should be sent in an appendage
+		nbbo = builder.Update(quote(2, 1, "10.01", "300", "10.06", "100", Financial_Quotes_Regular))
+		verifyNBBO(nbbo, 2, "10.01", "300", 1, "10.05", "200",
+			Financial_Quotes_Regular, Financial_Quotes_NBBOBBBOShortAppendage)
+
+		// Repeat the quote from the second venue; the NBBO should not change
+		nbbo = builder.Update(quote(2, 2, "10.01", "300", "10.06", "100", Financial_Quotes_Regular))
+		Expect(nbbo.Indicator).Should(Equal(Financial_Quotes_NBBONoChange))
+		Expect(builder.Current().Timestamp).Should(Equal(NewUnixTimestamp(base+1, 0)))
+
+		// Add a non-firm quote from a third venue; it should be ignored
+		nbbo = builder.Update(quote(3, 3, "10.10", "100", "10.02", "100", Financial_Quotes_NonFirm))
+		Expect(nbbo.Indicator).Should(Equal(Financial_Quotes_NBBONoChange))
+
+		// Add a slow-ask quote from the third venue; only the bid should be included
+		nbbo = builder.Update(quote(3, 4, "10.02", "100", "9", "100", Financial_Quotes_SlowAsk))
+		verifyNBBO(nbbo, 3, "10.02", "100", 1, "10.05", "200",
+			Financial_Quotes_Regular, Financial_Quotes_NBBOBBBOShortAppendage)
+
+		// Add a quote from a fourth venue that locks the market
+		nbbo = builder.Update(quote(4, 5, "10.03", "100", "10.03", "100", Financial_Quotes_Regular))
+		verifyNBBO(nbbo, 4, "10.03", "100", 4, "10.03", "100",
+			Financial_Quotes_LockedMarket, Financial_Quotes_NBBOQuoteIsNBBO)
+
+		// Add a quote from a fifth venue that crosses the market
+		nbbo = builder.Update(quote(5, 6, "10.1", "200", "10.2", "100", Financial_Quotes_Regular))
+		verifyNBBO(nbbo, 5, "10.1", "200", 4, "10.03", "100",
+			Financial_Quotes_CrossedMarket, Financial_Quotes_NBBOBBBOShortAppendage)
+
+		// Finally, close the fourth and fifth venues; the NBBO should revert to the third and first venues
+		builder.Update(quote(4, 7, "", "", "", "", Financial_Quotes_Closed))
+		nbbo = builder.Update(quote(5, 8, "10.1", "200", "10.2", "100", Financial_Quotes_MarketMakerQuotesClosed))
+		verifyNBBO(nbbo, 3, "10.02", "100", 1, "10.05", "200",
+			Financial_Quotes_Regular, Financial_Quotes_NBBOBBBOShortAppendage)
+		Expect(builder.Current()).Should(Equal(nbbo))
+	})
+
+	// Tests that the Update function breaks ties between venues at the same price by size and then by time
+	It("Update - Ties - Largest size, then earliest quote", func() {
+		builder := NewNBBOBuilder()
+		builder.Update(quote(1, 0, "10", "100", "10.05", "100", Financial_Quotes_Regular))
+		builder.Update(quote(2, 1, "10", "100", "10.05", "300", Financial_Quotes_Regular))
+		nbbo := builder.Update(quote(3, 2, "10", "100", "10.05", "100", Financial_Quotes_Regular))
+		verifyNBBO(nbbo, 1, "10", "100", 2, "10.05", "300",
+			Financial_Quotes_Regular, Financial_Quotes_NBBONoChange)
+
+		// Update the size on the first venue's bid; it should keep its place in time priority
+		nbbo = builder.Update(quote(1, 3, "10", "200", "10.05", "100", Financial_Quotes_Regular))
+		verifyNBBO(nbbo, 1, "10", "200", 2, "10.05", "300",
+			Financial_Quotes_Regular, Financial_Quotes_NBBOBBBOShortAppendage)
+	})
+
+	// Tests that the Update function returns the correct indicator when the NBBO cannot be sent in short form
+	DescribeTable("Update - Long appendage",
+		func(bid string, bidSize string) {
+			builder := NewNBBOBuilder()
+			builder.Update(quote(1, 0, "10", "100", "1000", "100", Financial_Quotes_Regular))
+			nbbo := builder.Update(quote(2, 1, bid, bidSize, "2000", "100", Financial_Quotes_Regular))
+			Expect(nbbo.Indicator).Should(Equal(Financial_Quotes_NBBOBBBOLongAppendage))
+		},
+		Entry("Price too large - Long", "700", "100"),
+		Entry("Sub-penny price - Long", "10.005", "100"),
+		Entry("Odd-lot size - Long", "10.01", "150"))
+
+	// Tests that the Update function returns NBBONoBBNoBO if there are no eligible quotes
+	It("Update - No eligible quotes - No BB, No BO", func() {
+		builder := NewNBBOBuilder()
+		nbbo := builder.Update(quote(1, 0, "10", "100", "10.05", "100", Financial_Quotes_SlowBidAsk))
+		Expect(nbbo.BidPrice).Should(BeNil())
+		Expect(nbbo.AskPrice).Should(BeNil())
+		Expect(nbbo.Condition).Should(Equal(Financial_Quotes_Regular))
+		Expect(nbbo.Indicator).Should(Equal(Financial_Quotes_NBBONoBBNoBO))
+	})
+})
@@ -0,0 +1,245 @@
+package gopb
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// ProviderCodes contains the tables used to translate the numeric condition, indicator and exchange IDs
+// used by a provider to and from our enums. Exchanges are identified by their ISO 10383 MIC
+type ProviderCodes struct {
+	TradeConditions map[int32]Financial_Trades_Condition
+	QuoteConditions map[int32]Financial_Quotes_Condition
+	QuoteIndicators map[int32]Financial_Quotes_Indicator
+	Exchanges       map[int32]string
+	tradeIDs        map[Financial_Trades_Condition]int32
+	quoteIDs        map[Financial_Quotes_Condition]int32
+	indicatorIDs    map[Financial_Quotes_Indicator]int32
+	exchangeIDs     map[string]int32
+}
+
+// NewProviderCodes creates a new ProviderCodes from the tables provided. Each table must map a single ID
+// to each enum value so that the tables can be used in reverse
+func NewProviderCodes(trades map[int32]Financial_Trades_Condition, quotes map[int32]Financial_Quotes_Condition,
+	indicators map[int32]Financial_Quotes_Indicator, exchanges map[int32]string) *ProviderCodes {
+	return &ProviderCodes{
+		TradeConditions: trades,
+		QuoteConditions: quotes,
+		QuoteIndicators: indicators,
+		Exchanges:       exchanges,
+		tradeIDs:        invert(trades),
+		quoteIDs:        invert(quotes),
+		indicatorIDs:    invert(indicators),
+		exchangeIDs:     invert(exchanges),
+	}
+}
+
+// PolygonCodes contains the tables used to translate Polygon's numeric IDs. Polygon's trade condition IDs
+// match our enum values. Its quote condition IDs do as well, except that Polygon uses -1 for an invalid
+// quote, which cannot be negative in a protobuf enum. This is because the Financial.Trades.Condition and
+// Financial.Quotes.Condition enums were numbered from the condition IDs Polygon publishes through its
+// conditions endpoints (https://api.polygon.io/v3/reference/conditions and the legacy
+// https://api.polygon.io/v1/meta/conditions/trades and /quotes), and the comment on each enum value is
+// the name Polygon gives that ID. Polygon's NBBO, held trade, retail interest, FINRA and CTA indicator IDs
+// are numbered from 601 rather than 101, and its exchange IDs are mapped to the MICs of the equity
+// exchanges they represent
+var PolygonCodes = NewProviderCodes(
+	polygonTradeConditions(), polygonQuoteConditions(), polygonQuoteIndicators(), polygonExchanges)
+
+// Offset applied by Polygon to the indicators numbered from 101
+const polygonIndicatorOffset = 500
+
+// Polygon's exchange IDs, mapped to the MICs of the exchanges they represent
+var polygonExchanges = map[int32]string{
+	1:  "XASE", // NYSE American
+	2:  "XBOS", // Nasdaq OMX BX
+	3:  "XCIS", // NYSE National
+	4:  "XADF", // FINRA Alternative Display Facility
+	6:  "XISE", // Nasdaq ISE
+	7:  "EDGA", // Cboe EDGA
+	8:  "EDGX", // Cboe EDGX
+	9:  "XCHI", // NYSE Chicago
+	10: "XNYS", // New York Stock Exchange
+	11: "ARCX", // NYSE Arca
+	12: "XNAS", // Nasdaq
+	14: "LTSE", // Long-Term Stock Exchange
+	15: "IEXG", // Investors Exchange
+	17: "XPHL", // Nasdaq OMX PSX
+	18: "BATY", // Cboe BYX
+	19: "BATS", // Cboe BZX
+	20: "EPRL", // MIAX Pearl
+	21: "MEMX", // Members Exchange
+}
+
+// ProviderCodeTables contains the code tables associated with each provider that uses numeric IDs
+var ProviderCodeTables = map[Provider]*ProviderCodes{
+	Provider_Polygon: PolygonCodes,
+}
+
+// DecodeTradeCondition converts a provider's trade condition ID to its associated Financial.Trades.Condition
+func DecodeTradeCondition(provider Provider, id int32) (Financial_Trades_Condition, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeID(codes.TradeConditions, provider, id)
+}
+
+// EncodeTradeCondition converts a Financial.Trades.Condition to the trade condition ID used by a provider
+func EncodeTradeCondition(provider Provider, cond Financial_Trades_Condition) (int32, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return encodeID(codes.tradeIDs, provider, cond)
+}
+
+// DecodeQuoteCondition converts a provider's quote condition ID to its associated Financial.Quotes.Condition
+func DecodeQuoteCondition(provider Provider, id int32) (Financial_Quotes_Condition, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeID(codes.QuoteConditions, provider, id)
+}
+
+// EncodeQuoteCondition converts a Financial.Quotes.Condition to the quote condition ID used by a provider
+func EncodeQuoteCondition(provider Provider, cond Financial_Quotes_Condition) (int32, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return encodeID(codes.quoteIDs, provider, cond)
+}
+
+// DecodeQuoteIndicator converts a provider's indicator ID to its associated Financial.Quotes.Indicator
+func DecodeQuoteIndicator(provider Provider, id int32) (Financial_Quotes_Indicator, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeID(codes.QuoteIndicators, provider, id)
+}
+
+// EncodeQuoteIndicator converts a Financial.Quotes.Indicator to the indicator ID used by a provider
+func EncodeQuoteIndicator(provider Provider, indicator Financial_Quotes_Indicator) (int32, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return encodeID(codes.indicatorIDs, provider, indicator)
+}
+
+// DecodeExchange converts a provider's exchange ID to the MIC of the exchange it represents
+func DecodeExchange(provider Provider, id int32) (string, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return "", err
+	}
+
+	mic, ok := codes.Exchanges[id]
+	if !ok {
+		return "", fmt.Errorf("%w for provider %s", &utils.EnumError{Enum: "exchange ID",
+			Input: strconv.FormatInt(int64(id), 10), Err: utils.ErrUnknownEnumValue}, provider)
+	}
+
+	return mic, nil
+}
+
+// EncodeExchange converts the MIC of an exchange to the exchange ID used by a provider
+func EncodeExchange(provider Provider, mic string) (int32, error) {
+	codes, err := providerCodes(provider)
+	if err != nil {
+		return 0, err
+	}
+
+	id, ok := codes.exchangeIDs[mic]
+	if !ok {
+		return 0, fmt.Errorf("%w for provider %s", &utils.EnumError{Enum: "MIC", Input: mic,
+			Err: utils.ErrUnknownEnumName}, provider)
+	}
+
+	return id, nil
+}
+
+// Helper function that retrieves the code tables associated with a provider
+func providerCodes(provider Provider) (*ProviderCodes, error) {
+	codes, ok := ProviderCodeTables[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w as it does not have numeric code tables", &utils.EnumError{
+			Enum: fmt.Sprintf("%T", provider), Input: provider.String(), Err: utils.ErrUnknownEnumValue})
+	}
+
+	return codes, nil
+}
+
+// Helper function that converts a provider's numeric ID to its associated enum value
+func decodeID[T ~int32](codes map[int32]T, provider Provider, id int32) (T, error) {
+	value, ok := codes[id]
+	if !ok {
+		return 0, fmt.Errorf("%w for provider %s", &utils.EnumError{Enum: fmt.Sprintf("%T", value),
+			Input: fmt.Sprint(id), Err: utils.ErrUnknownEnumName}, provider)
+	}
+
+	return value, nil
+}
+
+// Helper function that converts an enum value to its associated numeric ID for a provider
+func encodeID[T ~int32](ids map[T]int32, provider Provider, value T) (int32, error) {
+	id, ok := ids[value]
+	if !ok {
+		return 0, fmt.Errorf("%w for provider %s", &utils.EnumError{Enum: fmt.Sprintf("%T", value),
+			Input: strconv.FormatInt(int64(value), 10), Err: utils.ErrUnknownEnumValue}, provider)
+	}
+
+	return id, nil
+}
+
+// Helper function that creates the trade condition table for Polygon, whose IDs match our enum values as
+// the enum was numbered from them
+func polygonTradeConditions() map[int32]Financial_Trades_Condition {
+	codes := make(map[int32]Financial_Trades_Condition, len(Financial_Trades_Condition_name))
+	for value := range Financial_Trades_Condition_name {
+		codes[value] = Financial_Trades_Condition(value)
+	}
+
+	return codes
+}
+
+// Helper function that creates the quote condition table for Polygon, whose IDs match our enum values as
+// the enum was numbered from them, except for the invalid condition, which Polygon represents with -1
+func polygonQuoteConditions() map[int32]Financial_Quotes_Condition {
+	codes := make(map[int32]Financial_Quotes_Condition, len(Financial_Quotes_Condition_name))
+	for value := range Financial_Quotes_Condition_name {
+		if cond := Financial_Quotes_Condition(value); cond == Financial_Quotes_Invalid {
+			codes[-1] = cond
+		} else {
+			codes[value] = cond
+		}
+	}
+
+	return codes
+}
+
+// Helper function that creates the quote indicator table for Polygon, whose IDs match our enum values
+// up to the NBBO indicators, after which they are offset
+func polygonQuoteIndicators() map[int32]Financial_Quotes_Indicator {
+	codes := make(map[int32]Financial_Quotes_Indicator, len(Financial_Quotes_Indicator_name))
+	for value := range Financial_Quotes_Indicator_name {
+		if indicator := Financial_Quotes_Indicator(value); indicator >= Financial_Quotes_NBBONoChange {
+			codes[value+polygonIndicatorOffset] = indicator
+		} else {
+			codes[value] = indicator
+		}
+	}
+
+	return codes
+}
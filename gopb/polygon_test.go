@@ -0,0 +1,134 @@
+package gopb
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Provider Code Tests", func() {
+
+	// Tests that every enum value has exactly one Polygon ID so that the tables can be used in reverse
+	It("PolygonCodes - All values included", func() {
+		Expect(PolygonCodes.tradeIDs).Should(HaveLen(len(Financial_Trades_Condition_name)))
+		Expect(PolygonCodes.quoteIDs).Should(HaveLen(len(Financial_Quotes_Condition_name)))
+		Expect(PolygonCodes.indicatorIDs).Should(HaveLen(len(Financial_Quotes_Indicator_name)))
+		Expect(PolygonCodes.exchangeIDs).Should(HaveLen(len(PolygonCodes.Exchanges)))
+	})
+
+	// Tests that Polygon trade condition IDs are converted in both directions
+	DescribeTable("DecodeTradeCondition - Works",
+		func(id int32, expected Financial_Trades_Condition) {
+			cond, err := DecodeTradeCondition(Provider_Polygon, id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cond).Should(Equal(expected))
+
+			encoded, err := EncodeTradeCondition(Provider_Polygon, cond)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(encoded).Should(Equal(id))
+		},
+		Entry("14 - Intermarket Sweep", int32(14), Financial_Trades_IntermarketSweep),
+		Entry("37 - Odd Lot Trade", int32(37), Financial_Trades_OddLotTrade),
+		Entry("219 - Intermarket Sweep Order", int32(219), Financial_Trades_IntermarketSweepOrder))
+
+	// Tests that Polygon quote condition IDs are converted in both directions
+	DescribeTable("DecodeQuoteCondition - Works",
+		func(id int32, expected Financial_Quotes_Condition) {
+			cond, err := DecodeQuoteCondition(Provider_Polygon, id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cond).Should(Equal(expected))
+
+			encoded, err := EncodeQuoteCondition(Provider_Polygon, cond)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(encoded).Should(Equal(id))
+		},
+		Entry("1 - Regular, Two-Sided Open", int32(1), Financial_Quotes_RegularTwoSidedOpen),
+		Entry("71 - Slow Due LRP, Bid, Ask", int32(71), Financial_Quotes_SlowDueLRPBidAsk),
+		Entry("-1 - Invalid", int32(-1), Financial_Quotes_Invalid))
+
+	// Tests that Polygon's published trade condition IDs decode to the conditions Polygon names for them
+	DescribeTable("DecodeTradeCondition - Polygon published IDs - Works",
+		func(id int32, name string) {
+			cond, err := DecodeTradeCondition(Provider_Polygon, id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tradeConditionCodec.Describe(cond).Description).Should(Equal(name))
+		},
+		Entry("0 - Regular Sale", int32(0), "Regular Sale"),
+		Entry("12 - Form T", int32(12), "Form T"),
+		Entry("13 - Extended Trading Hours", int32(13), "Extended Trading Hours (Sold Out of Sequence)"),
+		Entry("33 - Sold Out of Sequence", int32(33), "Sold (Out of Sequence)"),
+		Entry("41 - Trade Thru Exempt", int32(41), "Trade Thru Exempt"),
+		Entry("53 - Qualified Contingent Trade", int32(53), "Qualified Contingent Trade (QCT)"),
+		Entry("209 - Automatic Execution", int32(209), "Automatic Execution (options)"),
+		Entry("232 - Multi Leg Auto-Electronic Trade", int32(232), "Multi Leg auto-electronic trade"))
+
+	// Tests that Polygon's published quote condition IDs decode to the conditions Polygon names for them
+	DescribeTable("DecodeQuoteCondition - Polygon published IDs - Works",
+		func(id int32, name string) {
+			cond, err := DecodeQuoteCondition(Provider_Polygon, id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(quoteConditionCodec.Describe(cond).Description).Should(Equal(name))
+		},
+		Entry("0 - Regular", int32(0), "Regular"),
+		Entry("8 - Slow Due NYSE LRP", int32(8), "Slow Due NYSE LRP"),
+		Entry("36 - Market-Wide Circuit Breaker 1", int32(36), "Market-Wide Circuit Breaker 1"),
+		Entry("43 - LULD Trading Pause", int32(43), "LULD Trading Pause"),
+		Entry("80 - Cancel", int32(80), "Cancel"))
+
+	// Tests that Polygon indicator IDs are converted in both directions
+	DescribeTable("DecodeQuoteIndicator - Works",
+		func(id int32, expected Financial_Quotes_Indicator) {
+			indicator, err := DecodeQuoteIndicator(Provider_Polygon, id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(indicator).Should(Equal(expected))
+
+			encoded, err := EncodeQuoteIndicator(Provider_Polygon, indicator)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(encoded).Should(Equal(id))
+		},
+		Entry("1 - NBB below Lower Band", int32(1), Financial_Quotes_NBBBelowLowerBand),
+		Entry("100 - Short Sales Restriction Max", int32(100), Financial_Quotes_ShortSalesRestrictionMax),
+		Entry("601 - NBBO No Change", int32(601), Financial_Quotes_NBBONoChange),
+		Entry("604 - NBBO Short Appendage", int32(604), Financial_Quotes_NBBOBBBOShortAppendage),
+		Entry("625 - CTA Cancelled Market Imbalance", int32(625), Financial_Quotes_CTACancelledMarketImbalance))
+
+	// Tests that Polygon exchange IDs are converted in both directions
+	DescribeTable("DecodeExchange - Works",
+		func(id int32, expected string) {
+			mic, err := DecodeExchange(Provider_Polygon, id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mic).Should(Equal(expected))
+
+			encoded, err := EncodeExchange(Provider_Polygon, mic)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(encoded).Should(Equal(id))
+		},
+		Entry("10 - NYSE", int32(10), "XNYS"),
+		Entry("12 - Nasdaq", int32(12), "XNAS"),
+		Entry("19 - Cboe BZX", int32(19), "BATS"))
+
+	// Tests that the decode and encode functions return errors for unknown providers and codes
+	It("Decode, Encode - Failures", func() {
+		_, err := DecodeTradeCondition(Provider_None, 14)
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of None is not a valid gopb.Provider as it does not have numeric code tables"))
+
+		_, err = DecodeQuoteIndicator(Provider_Polygon, 101)
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of \"101\" cannot be mapped to a gopb.Financial_Quotes_Indicator for provider Polygon"))
+
+		_, err = EncodeQuoteCondition(Provider_Polygon, Financial_Quotes_Condition(60))
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of 60 is not a valid gopb.Financial_Quotes_Condition for provider Polygon"))
+
+		_, err = DecodeExchange(Provider_Polygon, 5)
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of 5 is not a valid exchange ID for provider Polygon"))
+
+		_, err = EncodeExchange(Provider_Polygon, "XLON")
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of \"XLON\" cannot be mapped to a MIC for provider Polygon"))
+	})
+})
@@ -0,0 +1,200 @@
+package gopb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// SIPFeed identifies a feed that disseminates single-character condition and indicator codes
+type SIPFeed string
+
+const (
+	CTAFeed       SIPFeed = "CTA"        // The CTA feeds (CTS and CQS), which disseminate Tape A and B data
+	UTPFeed       SIPFeed = "UTP"        // The UTP feeds (UTDF and UQDF), which disseminate Tape C data
+	FINRATDDSFeed SIPFeed = "FINRA_TDDS" // The FINRA Trade Data Dissemination Service
+	OPRAFeed      SIPFeed = "OPRA"       // The Options Price Reporting Authority feed
+)
+
+// SIPIndicatorField identifies the field of a quote message in which an indicator code is disseminated.
+// This is required because the same code has different meanings in different fields
+type SIPIndicatorField string
+
+const (
+	LULDIndicatorField        SIPIndicatorField = "LULD" // The LULD National BBO indicator
+	NBBOAppendageField        SIPIndicatorField = "NBBO" // The National BBO appendage indicator
+	ShortSaleRestrictionField SIPIndicatorField = "SSR"  // The short sale restriction indicator
+)
+
+// SIPFeedTapes contains the tapes for which each feed disseminates data. Feeds that aren't included
+// here are not associated with a tape
+var SIPFeedTapes = map[SIPFeed][]Financial_Common_Tape{
+	CTAFeed:       {Financial_Common_A, Financial_Common_B},
+	UTPFeed:       {Financial_Common_C},
+	FINRATDDSFeed: {Financial_Common_A, Financial_Common_B, Financial_Common_C},
+}
+
+// SIPTradeConditionCodes contains the Financial.Trades.Condition associated with each sale condition
+// code, for each feed that disseminates trades. The codes are read from aliases.yaml
+var SIPTradeConditionCodes = feedCodes(tradeConditionCodes)
+
+// SIPQuoteConditionCodes contains the Financial.Quotes.Condition associated with each quote condition
+// code, for each feed that disseminates quotes. The codes are read from aliases.yaml
+var SIPQuoteConditionCodes = feedCodes(quoteConditionCodes)
+
+// SIPQuoteIndicatorCodes contains the Financial.Quotes.Indicator associated with each indicator code,
+// for each field and each feed that disseminates that field. The codes are read from aliases.yaml, where
+// they are listed by feed and field, separated by a colon
+var SIPQuoteIndicatorCodes = fieldCodes(quoteIndicatorCodes)
+
+// Reverse lookups for the code tables above
+var (
+	sipTradeConditions = invertFeeds(SIPTradeConditionCodes)
+	sipQuoteConditions = invertFeeds(SIPQuoteConditionCodes)
+	sipQuoteIndicators = invertFields(SIPQuoteIndicatorCodes)
+)
+
+// TradeConditionFromSIP converts a sale condition code, reported on the feed and tape provided, to its
+// associated Financial.Trades.Condition. An error will be returned if the feed does not disseminate data
+// for the tape or if the code is not defined on the feed
+func TradeConditionFromSIP(feed SIPFeed, tape Financial_Common_Tape, code string) (Financial_Trades_Condition, error) {
+	return fromSIP(SIPTradeConditionCodes[feed], feed, tape, code)
+}
+
+// TradeConditionToSIP converts a Financial.Trades.Condition to the sale condition code used for it on the
+// feed and tape provided. An error will be returned if the feed does not disseminate data for the tape
+// or if the condition has no code on the feed
+func TradeConditionToSIP(feed SIPFeed, tape Financial_Common_Tape, cond Financial_Trades_Condition) (string, error) {
+	return toSIP(sipTradeConditions[feed], feed, tape, cond)
+}
+
+// QuoteConditionFromSIP converts a quote condition code, reported on the feed and tape provided, to its
+// associated Financial.Quotes.Condition. An error will be returned if the feed does not disseminate data
+// for the tape or if the code is not defined on the feed
+func QuoteConditionFromSIP(feed SIPFeed, tape Financial_Common_Tape, code string) (Financial_Quotes_Condition, error) {
+	return fromSIP(SIPQuoteConditionCodes[feed], feed, tape, code)
+}
+
+// QuoteConditionToSIP converts a Financial.Quotes.Condition to the quote condition code used for it on the
+// feed and tape provided. An error will be returned if the feed does not disseminate data for the tape
+// or if the condition has no code on the feed
+func QuoteConditionToSIP(feed SIPFeed, tape Financial_Common_Tape, cond Financial_Quotes_Condition) (string, error) {
+	return toSIP(sipQuoteConditions[feed], feed, tape, cond)
+}
+
+// QuoteIndicatorFromSIP converts an indicator code, reported in the field of a quote on the feed and tape
+// provided, to its associated Financial.Quotes.Indicator. An error will be returned if the feed does not
+// disseminate data for the tape or if the code is not defined for the field on the feed
+func QuoteIndicatorFromSIP(feed SIPFeed, tape Financial_Common_Tape, field SIPIndicatorField,
+	code string) (Financial_Quotes_Indicator, error) {
+	return fromSIP(SIPQuoteIndicatorCodes[field][feed], feed, tape, code)
+}
+
+// QuoteIndicatorToSIP converts a Financial.Quotes.Indicator to the indicator code used for it in the field
+// of a quote on the feed and tape provided. An error will be returned if the feed does not disseminate
+// data for the tape or if the indicator has no code for the field on the feed
+func QuoteIndicatorToSIP(feed SIPFeed, tape Financial_Common_Tape, field SIPIndicatorField,
+	indicator Financial_Quotes_Indicator) (string, error) {
+	return toSIP(sipQuoteIndicators[field][feed], feed, tape, indicator)
+}
+
+// Helper function that converts a code on a feed to its associated enum value
+func fromSIP[T ~int32](codes map[string]T, feed SIPFeed, tape Financial_Common_Tape, code string) (T, error) {
+	if err := checkFeedTape(feed, tape); err != nil {
+		return T(0), err
+	}
+
+	value, ok := codes[code]
+	if !ok {
+		return T(0), fmt.Errorf("%w on the %s feed", &utils.EnumError{Enum: fmt.Sprintf("%T", value), Input: code,
+			Err: utils.ErrUnknownEnumName}, feed)
+	}
+
+	return value, nil
+}
+
+// Helper function that converts an enum value to its associated code on a feed
+func toSIP[T ~int32](codes map[T]string, feed SIPFeed, tape Financial_Common_Tape, value T) (string, error) {
+	if err := checkFeedTape(feed, tape); err != nil {
+		return "", err
+	}
+
+	code, ok := codes[value]
+	if !ok {
+		return "", fmt.Errorf("%w on the %s feed", &utils.EnumError{Enum: fmt.Sprintf("%T", value),
+			Input: strconv.FormatInt(int64(value), 10), Err: utils.ErrUnknownEnumValue}, feed)
+	}
+
+	return code, nil
+}
+
+// Helper function that verifies that a feed disseminates data for the tape provided
+func checkFeedTape(feed SIPFeed, tape Financial_Common_Tape) error {
+	if tapes, ok := SIPFeedTapes[feed]; ok && !containsTape(tapes, tape) {
+		return fmt.Errorf("%w on the %s feed", &utils.EnumError{Enum: fmt.Sprintf("%T", tape),
+			Input: tape.String(), Err: utils.ErrUnknownEnumValue}, feed)
+	}
+
+	return nil
+}
+
+// Helper function that converts the generated code tables for an enum, which are keyed by the name of the
+// feed that disseminates them, into tables keyed by SIPFeed. The tables are copied so that modifying them
+// does not affect the generated tables
+func feedCodes[T ~int32](groups map[string]map[string]T) map[SIPFeed]map[string]T {
+	feeds := make(map[SIPFeed]map[string]T, len(groups))
+	for feed, codes := range groups {
+		feeds[SIPFeed(feed)] = copyTable(codes)
+	}
+
+	return feeds
+}
+
+// Helper function that converts the generated code tables for an enum, which are keyed by the name of the
+// feed and field that disseminate them, separated by a colon, into tables keyed by SIPIndicatorField
+// and SIPFeed
+func fieldCodes[T ~int32](groups map[string]map[string]T) map[SIPIndicatorField]map[SIPFeed]map[string]T {
+	fields := make(map[SIPIndicatorField]map[SIPFeed]map[string]T)
+	for group, codes := range groups {
+		feed, field, _ := strings.Cut(group, ":")
+		if _, ok := fields[SIPIndicatorField(field)]; !ok {
+			fields[SIPIndicatorField(field)] = make(map[SIPFeed]map[string]T)
+		}
+
+		fields[SIPIndicatorField(field)][SIPFeed(feed)] = copyTable(codes)
+	}
+
+	return fields
+}
+
+// Helper function that inverts the code tables for each feed
+func invertFeeds[T comparable](feeds map[SIPFeed]map[string]T) map[SIPFeed]map[T]string {
+	inverted := make(map[SIPFeed]map[T]string, len(feeds))
+	for feed, codes := range feeds {
+		inverted[feed] = invert(codes)
+	}
+
+	return inverted
+}
+
+// Helper function that inverts the code tables for each field and feed
+func invertFields[T comparable](fields map[SIPIndicatorField]map[SIPFeed]map[string]T) map[SIPIndicatorField]map[SIPFeed]map[T]string {
+	inverted := make(map[SIPIndicatorField]map[SIPFeed]map[T]string, len(fields))
+	for field, feeds := range fields {
+		inverted[field] = invertFeeds(feeds)
+	}
+
+	return inverted
+}
+
+// Helper function that inverts a mapping so that its values map to their keys
+func invert[K comparable, V comparable](mapping map[K]V) map[V]K {
+	inverted := make(map[V]K, len(mapping))
+	for key, value := range mapping {
+		inverted[value] = key
+	}
+
+	return inverted
+}
@@ -0,0 +1,121 @@
+package gopb
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("SIP Code Tests", func() {
+
+	// Tests that no two codes on the same feed map to the same enum value so that the tables can be reversed
+	It("SIP code tables - Codes unique per feed", func() {
+		for feed, codes := range SIPTradeConditionCodes {
+			Expect(sipTradeConditions[feed]).Should(HaveLen(len(codes)), "Duplicate trade condition on %s", feed)
+		}
+
+		for feed, codes := range SIPQuoteConditionCodes {
+			Expect(sipQuoteConditions[feed]).Should(HaveLen(len(codes)), "Duplicate quote condition on %s", feed)
+		}
+
+		for field, feeds := range SIPQuoteIndicatorCodes {
+			for feed, codes := range feeds {
+				Expect(sipQuoteIndicators[field][feed]).Should(HaveLen(len(codes)),
+					"Duplicate quote indicator for %s on %s", field, feed)
+			}
+		}
+	})
+
+	// Tests that the same code is converted to different conditions depending on the feed
+	DescribeTable("TradeConditionFromSIP - Works",
+		func(feed SIPFeed, tape Financial_Common_Tape, code string, expected Financial_Trades_Condition) {
+			cond, err := TradeConditionFromSIP(feed, tape, code)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cond).Should(Equal(expected))
+
+			// Verify that the conversion can be reversed
+			reversed, err := TradeConditionToSIP(feed, tape, cond)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reversed).Should(Equal(code))
+		},
+		Entry("CTA, @ - Regular Sale", CTAFeed, Financial_Common_A, "@", Financial_Trades_RegularSale),
+		Entry("UTP, @ - Regular Sale", UTPFeed, Financial_Common_C, "@", Financial_Trades_RegularSale),
+		Entry("CTA, I - CAP Election", CTAFeed, Financial_Common_B, "I", Financial_Trades_CAPElection),
+		Entry("UTP, I - Odd Lot Trade", UTPFeed, Financial_Common_C, "I", Financial_Trades_OddLotTrade),
+		Entry("CTA, T - Form T", CTAFeed, Financial_Common_A, "T", Financial_Trades_FormT),
+		Entry("FINRA TDDS, I - Odd Lot Trade", FINRATDDSFeed, Financial_Common_A, "I", Financial_Trades_OddLotTrade),
+		Entry("OPRA, a - Single-Leg Auction, Non-ISO", OPRAFeed, Financial_Common_A, "a",
+			Financial_Trades_SingleLegAuctionNonISO))
+
+	// Tests that the TradeConditionFromSIP and TradeConditionToSIP functions return errors for invalid inputs
+	It("TradeConditionFromSIP, TradeConditionToSIP - Failures", func() {
+		_, err := TradeConditionFromSIP(UTPFeed, Financial_Common_A, "@")
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of A is not a valid gopb.Financial_Common_Tape on the UTP feed"))
+
+		_, err = TradeConditionFromSIP(CTAFeed, Financial_Common_A, "J")
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of \"J\" cannot be mapped to a gopb.Financial_Trades_Condition on the CTA feed"))
+
+		_, err = TradeConditionToSIP(CTAFeed, Financial_Common_A, Financial_Trades_Acquisition)
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of 1 is not a valid gopb.Financial_Trades_Condition on the CTA feed"))
+	})
+
+	// Tests that quote condition codes are converted in both directions
+	DescribeTable("QuoteConditionFromSIP - Works",
+		func(feed SIPFeed, tape Financial_Common_Tape, code string, expected Financial_Quotes_Condition) {
+			cond, err := QuoteConditionFromSIP(feed, tape, code)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cond).Should(Equal(expected))
+
+			// Verify that the conversion can be reversed
+			reversed, err := QuoteConditionToSIP(feed, tape, cond)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reversed).Should(Equal(code))
+		},
+		Entry("CTA, A - Slow Ask", CTAFeed, Financial_Common_A, "A", Financial_Quotes_SlowAsk),
+		Entry("UTP, A - Manual Ask, Automated Bid", UTPFeed, Financial_Common_C, "A",
+			Financial_Quotes_ManualAskAutomatedBid),
+		Entry("CTA, R - Regular", CTAFeed, Financial_Common_B, "R", Financial_Quotes_Regular),
+		Entry("UTP, N - Non-Firm", UTPFeed, Financial_Common_C, "N", Financial_Quotes_NonFirm))
+
+	// Tests that quote indicator codes are converted in both directions, according to their field
+	DescribeTable("QuoteIndicatorFromSIP - Works",
+		func(feed SIPFeed, tape Financial_Common_Tape, field SIPIndicatorField, code string,
+			expected Financial_Quotes_Indicator) {
+			indicator, err := QuoteIndicatorFromSIP(feed, tape, field, code)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(indicator).Should(Equal(expected))
+
+			// Verify that the conversion can be reversed
+			reversed, err := QuoteIndicatorToSIP(feed, tape, field, indicator)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reversed).Should(Equal(code))
+		},
+		Entry("CTA, LULD, B - NBB Below Lower Band", CTAFeed, Financial_Common_A, LULDIndicatorField, "B",
+			Financial_Quotes_NBBBelowLowerBand),
+		Entry("UTP, LULD, A - Executable", UTPFeed, Financial_Common_C, LULDIndicatorField, "A",
+			Financial_Quotes_NBBNBOExecutable),
+		Entry("UTP, NBBO, 2 - Short Appendage", UTPFeed, Financial_Common_C, NBBOAppendageField, "2",
+			Financial_Quotes_NBBOBBBOShortAppendage),
+		Entry("CTA, SSR, A - Activated", CTAFeed, Financial_Common_B, ShortSaleRestrictionField, "A",
+			Financial_Quotes_ShortSalesRestrictionActivated))
+
+	// Tests that SIP codes are included in the alternates so that they can be unmarshalled directly
+	It("UnmarshalCSV - SIP codes - Works", func() {
+		var trade Financial_Trades_Condition
+		Expect(trade.UnmarshalCSV("UTP:@")).ShouldNot(HaveOccurred())
+		Expect(trade).Should(Equal(Financial_Trades_RegularSale))
+
+		var quote Financial_Quotes_Condition
+		Expect(quote.UnmarshalCSV("UTP:L")).ShouldNot(HaveOccurred())
+		Expect(quote).Should(Equal(Financial_Quotes_MarketMakerQuotesClosed))
+
+		var indicator Financial_Quotes_Indicator
+		Expect(indicator.UnmarshalCSV("CTA:SSR:D")).ShouldNot(HaveOccurred())
+		Expect(indicator).Should(Equal(Financial_Quotes_ShortSalesRestrictionDeactivated))
+	})
+})
@@ -0,0 +1,324 @@
+package gopb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// TradingStatus describes the trading state of a single symbol at a point in time, as determined by
+// the quote indicators that have been received for it. The halt reason is only set while the symbol is
+// halted, and is nil otherwise. The MWCB level contains the highest market-wide circuit breaker level
+// reached during the current session; since circuit breakers apply for a single trading day, this should
+// be cleared with ResetSession at the start of each day
+type TradingStatus struct {
+	Timestamp           *UnixTimestamp
+	Halted              bool
+	HaltReason          *Financial_Quotes_Indicator
+	ShortSaleRestricted bool
+	NBBLimitState       bool
+	NBOLimitState       bool
+	MWCBLevel           int
+	MWCBHalted          bool
+}
+
+// TradingStatusTransition describes a change in the trading status of a symbol caused by an indicator
+type TradingStatusTransition struct {
+	Symbol    string
+	Indicator Financial_Quotes_Indicator
+	Timestamp *UnixTimestamp
+	Previous  TradingStatus
+	Current   TradingStatus
+}
+
+// TradingHaltIndicators contains the quote indicators which halt or pause trading in a symbol
+var TradingHaltIndicators = map[Financial_Quotes_Indicator]bool{
+	Financial_Quotes_OpeningDelay:                                true,
+	Financial_Quotes_TradingHalt:                                 true,
+	Financial_Quotes_NoOpenNoResume:                              true,
+	Financial_Quotes_VolatilityTradingPause:                      true,
+	Financial_Quotes_HaltNewsPending:                             true,
+	Financial_Quotes_HaltSingleStockTradingPause:                 true,
+	Financial_Quotes_HaltRegulatoryExtraordinaryMarketActivity:   true,
+	Financial_Quotes_HaltETF:                                     true,
+	Financial_Quotes_HaltInformationRequested:                    true,
+	Financial_Quotes_HaltExchangeNonCompliance:                   true,
+	Financial_Quotes_HaltFilingsNotCurrent:                       true,
+	Financial_Quotes_HaltSECTradingSuspension:                    true,
+	Financial_Quotes_HaltRegulatoryConcern:                       true,
+	Financial_Quotes_HaltMarketOperations:                        true,
+	Financial_Quotes_IPOSecurityNotYetTrading:                    true,
+	Financial_Quotes_HaltCorporateAction:                         true,
+	Financial_Quotes_HaltVolatilityTradingPause:                  true,
+	Financial_Quotes_HaltVolatilityTradingPauseStraddleCondition: true,
+	Financial_Quotes_HaltSingleStockTradingPauseQuotesOnly:       true,
+	Financial_Quotes_HaltSubPennyTrading:                         true,
+	Financial_Quotes_LULDTradingPaused:                           true,
+}
+
+// TradingResumeIndicators contains the quote indicators which resume trading in a halted symbol
+var TradingResumeIndicators = map[Financial_Quotes_Indicator]bool{
+	Financial_Quotes_NormalTrading:                             true,
+	Financial_Quotes_TradingResume:                             true,
+	Financial_Quotes_TradingResumption:                         true,
+	Financial_Quotes_ResumeQualificationIssuesReviewedResolved: true,
+	Financial_Quotes_ResumeFilingRequirementsSatisfiedResolved: true,
+	Financial_Quotes_ResumeNewsNotForthcoming:                  true,
+	Financial_Quotes_ResumeQualificationsMaintRequirementsMet:  true,
+	Financial_Quotes_ResumeQualificationsFilingsMet:            true,
+	Financial_Quotes_ResumeRegulatoryAuth:                      true,
+	Financial_Quotes_NewIssueAvailable:                         true,
+	Financial_Quotes_IssueAvailable:                            true,
+}
+
+// Tradable returns whether or not the symbol can be traded with this status. If it cannot, then a
+// description of the reasons why will also be returned
+func (status TradingStatus) Tradable() (bool, string) {
+	reasons := make([]string, 0)
+	if status.MWCBHalted {
+		reasons = append(reasons, fmt.Sprintf("market-wide circuit breaker level %d", status.MWCBLevel))
+	}
+
+	if status.Halted {
+		if status.HaltReason != nil {
+			reasons = append(reasons, fmt.Sprintf("trading halted (%s)", quoteIndicatorMapping[*status.HaltReason]))
+		} else {
+			reasons = append(reasons, "trading halted")
+		}
+	}
+
+	return len(reasons) == 0, strings.Join(reasons, "; ")
+}
+
+// Apply returns the status that results from applying an indicator, received at the timestamp provided,
+// to this status. The second return value will be false if the indicator does not affect the status
+func (status TradingStatus) Apply(indicator Financial_Quotes_Indicator,
+	timestamp *UnixTimestamp) (TradingStatus, bool) {
+	next := status
+	switch {
+	case TradingHaltIndicators[indicator]:
+
+		// A halt clears any limit state as the bands will be recalculated once trading resumes
+		reason := indicator
+		next.Halted, next.HaltReason = true, &reason
+		next.NBBLimitState, next.NBOLimitState = false, false
+	case TradingResumeIndicators[indicator]:
+		next.Halted, next.HaltReason = false, nil
+	case indicator == Financial_Quotes_MWCBLevel1:
+		next.MWCBLevel, next.MWCBHalted = maxLevel(next.MWCBLevel, 1), true
+	case indicator == Financial_Quotes_MWCBLevel2:
+		next.MWCBLevel, next.MWCBHalted = maxLevel(next.MWCBLevel, 2), true
+	case indicator == Financial_Quotes_MWCBLevel3:
+
+		// A level 3 circuit breaker halts trading for the remainder of the day so it cannot be resumed
+		// until the session is reset
+		next.MWCBLevel, next.MWCBHalted = 3, true
+	case indicator == Financial_Quotes_MWCBResume:
+		next.MWCBHalted = next.MWCBLevel == 3
+	case indicator == Financial_Quotes_ShortSaleRestriction,
+		indicator == Financial_Quotes_ShortSalesRestrictionActivated,
+		indicator == Financial_Quotes_ShortSalesRestrictionContinued,
+		indicator == Financial_Quotes_ShortSalesRestrictionInEffect:
+		next.ShortSaleRestricted = true
+	case indicator == Financial_Quotes_ShortSalesRestrictionDeactivated:
+		next.ShortSaleRestricted = false
+	case indicator == Financial_Quotes_NBBLimitStateEntered:
+		next.NBBLimitState = true
+	case indicator == Financial_Quotes_NBBLimitStateExited:
+		next.NBBLimitState = false
+	case indicator == Financial_Quotes_NBOLimitStateEntered:
+		next.NBOLimitState = true
+	case indicator == Financial_Quotes_NBOLimitStateExited:
+		next.NBOLimitState = false
+	case indicator == Financial_Quotes_NBBAndNBOLimitStateEntered:
+		next.NBBLimitState, next.NBOLimitState = true, true
+	case indicator == Financial_Quotes_NBBAndNBOLimitStateExited:
+		next.NBBLimitState, next.NBOLimitState = false, false
+	case indicator == Financial_Quotes_NBBLimitStateEnteredNBOLimitStateExited:
+		next.NBBLimitState, next.NBOLimitState = true, false
+	case indicator == Financial_Quotes_NBBLimitStateExitedNBOLimitStateEntered:
+		next.NBBLimitState, next.NBOLimitState = false, true
+	default:
+		return status, false
+	}
+
+	// If the indicator didn't change anything then report that; otherwise, update the timestamp
+	if next.equals(status) {
+		return status, false
+	}
+
+	next.Timestamp = timestamp
+	return next, true
+}
+
+// ResetSession returns the status that results from starting a new trading session. Market-wide circuit
+// breakers only apply for the day on which they were triggered, so the MWCB level and halt are cleared.
+// Symbol-specific halts and restrictions can span multiple days so they are left as they are
+func (status TradingStatus) ResetSession() TradingStatus {
+	status.MWCBLevel, status.MWCBHalted = 0, false
+	return status
+}
+
+// Helper function that returns true if both statuses are the same, comparing halt reasons by value
+func (status TradingStatus) equals(other TradingStatus) bool {
+	lhsReason, rhsReason := status.HaltReason, other.HaltReason
+	status.HaltReason, other.HaltReason = nil, nil
+	if status != other || (lhsReason == nil) != (rhsReason == nil) {
+		return false
+	}
+
+	return lhsReason == nil || *lhsReason == *rhsReason
+}
+
+// Helper function that returns the greater of two circuit breaker levels
+func maxLevel(current int, level int) int {
+	if current > level {
+		return current
+	}
+
+	return level
+}
+
+// TradingStatusTracker tracks the trading status of a number of symbols from the quote indicators
+// received for them, along with the history of transitions for each symbol. Market-wide circuit breakers
+// are tracked for the market as a whole, so a circuit breaker reported for any symbol applies to every
+// symbol. The tracker is safe for concurrent use so that risk checks can query it while indicators are
+// being applied
+type TradingStatusTracker struct {
+	lock       *sync.RWMutex
+	statuses   map[string]TradingStatus
+	history    map[string][]*TradingStatusTransition
+	mwcbLevel  int
+	mwcbHalted bool
+}
+
+// NewTradingStatusTracker creates a new TradingStatusTracker with no symbols
+func NewTradingStatusTracker() *TradingStatusTracker {
+	return &TradingStatusTracker{
+		lock:     new(sync.RWMutex),
+		statuses: make(map[string]TradingStatus),
+		history:  make(map[string][]*TradingStatusTransition),
+	}
+}
+
+// Apply applies a quote indicator, received for the symbol at the timestamp provided, to the status of
+// that symbol. If the status changed as a result then the transition will be returned; otherwise, nil
+// will be returned. If the indicator changed the market-wide circuit breaker state then the change will
+// also be applied to, and recorded in the history of, every other symbol
+func (tracker *TradingStatusTracker) Apply(symbol string, indicator Financial_Quotes_Indicator,
+	timestamp *UnixTimestamp) *TradingStatusTransition {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	// First, attempt to apply the indicator to the current status; if this had no effect then return
+	previous := tracker.status(symbol)
+	current, changed := previous.Apply(indicator, timestamp)
+	if !changed {
+		return nil
+	}
+
+	// Next, update the status and record the transition in the symbol's history
+	transition := tracker.record(symbol, indicator, timestamp, previous, current)
+
+	// Finally, if the circuit breaker state changed then apply it to every other symbol. These are
+	// updated in order of symbol so that the histories are recorded deterministically
+	if current.MWCBLevel != previous.MWCBLevel || current.MWCBHalted != previous.MWCBHalted {
+		tracker.mwcbLevel, tracker.mwcbHalted = current.MWCBLevel, current.MWCBHalted
+		for _, other := range tracker.symbols() {
+			if other != symbol {
+				status := tracker.statuses[other]
+				next := status
+				next.MWCBLevel, next.MWCBHalted, next.Timestamp = current.MWCBLevel, current.MWCBHalted, timestamp
+				tracker.record(other, indicator, timestamp, status, next)
+			}
+		}
+	}
+
+	return transition
+}
+
+// ResetSession starts a new trading session at the timestamp provided, clearing the market-wide circuit
+// breaker state of every symbol. This should be called at the start of each trading day as otherwise a
+// level 3 circuit breaker will leave symbols untradable indefinitely. The transitions for each symbol whose
+// status changed are recorded in its history, with an indicator equivalent to utils.NoValue, and returned
+// in order of symbol
+func (tracker *TradingStatusTracker) ResetSession(timestamp *UnixTimestamp) []*TradingStatusTransition {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.mwcbLevel, tracker.mwcbHalted = 0, false
+	transitions := make([]*TradingStatusTransition, 0)
+	for _, symbol := range tracker.symbols() {
+		previous := tracker.statuses[symbol]
+		current := previous.ResetSession()
+		if current.equals(previous) {
+			continue
+		}
+
+		current.Timestamp = timestamp
+		transitions = append(transitions, tracker.record(symbol, utils.NoValue[Financial_Quotes_Indicator](),
+			timestamp, previous, current))
+	}
+
+	return transitions
+}
+
+// Status returns the current trading status of the symbol. Symbols for which no indicators have been
+// received are assumed to be trading normally, other than any market-wide circuit breaker in effect
+func (tracker *TradingStatusTracker) Status(symbol string) TradingStatus {
+	tracker.lock.RLock()
+	defer tracker.lock.RUnlock()
+	return tracker.status(symbol)
+}
+
+// Tradable returns whether or not the symbol can currently be traded and, if it cannot, why not
+func (tracker *TradingStatusTracker) Tradable(symbol string) (bool, string) {
+	return tracker.Status(symbol).Tradable()
+}
+
+// History returns all the transitions in the trading status of the symbol, in the order they were applied
+func (tracker *TradingStatusTracker) History(symbol string) []*TradingStatusTransition {
+	tracker.lock.RLock()
+	defer tracker.lock.RUnlock()
+	return append([]*TradingStatusTransition(nil), tracker.history[symbol]...)
+}
+
+// Helper function that retrieves the current status of a symbol. Symbols that aren't being tracked yet
+// have the market-wide circuit breaker state but are otherwise trading normally
+func (tracker *TradingStatusTracker) status(symbol string) TradingStatus {
+	if status, ok := tracker.statuses[symbol]; ok {
+		return status
+	}
+
+	return TradingStatus{MWCBLevel: tracker.mwcbLevel, MWCBHalted: tracker.mwcbHalted}
+}
+
+// Helper function that updates the status of a symbol and records the transition in its history
+func (tracker *TradingStatusTracker) record(symbol string, indicator Financial_Quotes_Indicator,
+	timestamp *UnixTimestamp, previous TradingStatus, current TradingStatus) *TradingStatusTransition {
+	transition := TradingStatusTransition{
+		Symbol:    symbol,
+		Indicator: indicator,
+		Timestamp: timestamp,
+		Previous:  previous,
+		Current:   current,
+	}
+
+	tracker.statuses[symbol] = current
+	tracker.history[symbol] = append(tracker.history[symbol], &transition)
+	return &transition
+}
+
+// Helper function that returns the symbols being tracked, in order
+func (tracker *TradingStatusTracker) symbols() []string {
+	symbols := make([]string, 0, len(tracker.statuses))
+	for symbol := range tracker.statuses {
+		symbols = append(symbols, symbol)
+	}
+
+	sort.Strings(symbols)
+	return symbols
+}
@@ -0,0 +1,184 @@
+package gopb
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Trading Status Tests", func() {
+
+	// Helper function that returns a pointer to an indicator, for use as a halt reason
+	reason := func(indicator Financial_Quotes_Indicator) *Financial_Quotes_Indicator {
+		return &indicator
+	}
+
+	// Tests that the Tradable function returns the reasons a symbol cannot be traded
+	DescribeTable("TradingStatus.Tradable - Works",
+		func(status TradingStatus, tradable bool, reason string) {
+			actual, actualReason := status.Tradable()
+			Expect(actual).Should(Equal(tradable))
+			Expect(actualReason).Should(Equal(reason))
+		},
+		Entry("Normal - Tradable", TradingStatus{}, true, ""),
+		Entry("Halted, no reason - Not tradable", TradingStatus{Halted: true}, false, "trading halted"),
+		Entry("SSR, limit state - Tradable", TradingStatus{ShortSaleRestricted: true, NBBLimitState: true}, true, ""),
+		Entry("Halted - Not tradable", TradingStatus{Halted: true, HaltReason: reason(Financial_Quotes_HaltNewsPending)},
+			false, "trading halted (Halt: News Pending)"),
+		Entry("MWCB resumed - Tradable", TradingStatus{MWCBLevel: 1}, true, ""),
+		Entry("MWCB halted and halted - Not tradable", TradingStatus{MWCBLevel: 2, MWCBHalted: true, Halted: true,
+			HaltReason: reason(Financial_Quotes_LULDTradingPaused)}, false,
+			"market-wide circuit breaker level 2; trading halted (LULD Trading Paused)"))
+
+	// Tests that the Apply function transitions the status correctly for various indicators
+	DescribeTable("TradingStatus.Apply - Works",
+		func(status TradingStatus, indicator Financial_Quotes_Indicator, expected TradingStatus, changed bool) {
+			timestamp := NewUnixTimestamp(base, 0)
+			if changed {
+				expected.Timestamp = timestamp
+			}
+
+			actual, actualChanged := status.Apply(indicator, timestamp)
+			Expect(actualChanged).Should(Equal(changed))
+			Expect(actual).Should(Equal(expected))
+		},
+		Entry("Halt - Halted, limit state cleared", TradingStatus{NBBLimitState: true},
+			Financial_Quotes_HaltVolatilityTradingPause,
+			TradingStatus{Halted: true, HaltReason: reason(Financial_Quotes_HaltVolatilityTradingPause)}, true),
+		Entry("Resume - Not halted", TradingStatus{Halted: true, HaltReason: reason(Financial_Quotes_HaltNewsPending)},
+			Financial_Quotes_ResumeNewsNotForthcoming, TradingStatus{}, true),
+		Entry("Resume while not halted - No change", TradingStatus{}, Financial_Quotes_TradingResume,
+			TradingStatus{}, false),
+		Entry("MWCB Level 1 - Halted", TradingStatus{}, Financial_Quotes_MWCBLevel1,
+			TradingStatus{MWCBLevel: 1, MWCBHalted: true}, true),
+		Entry("MWCB Resume - Resumed", TradingStatus{MWCBLevel: 2, MWCBHalted: true}, Financial_Quotes_MWCBResume,
+			TradingStatus{MWCBLevel: 2}, true),
+		Entry("MWCB Resume after Level 3 - No change", TradingStatus{MWCBLevel: 3, MWCBHalted: true},
+			Financial_Quotes_MWCBResume, TradingStatus{MWCBLevel: 3, MWCBHalted: true}, false),
+		Entry("MWCB Level 1 after Level 2 - Highest level kept", TradingStatus{MWCBLevel: 2},
+			Financial_Quotes_MWCBLevel1, TradingStatus{MWCBLevel: 2, MWCBHalted: true}, true),
+		Entry("Halt with same reason - No change",
+			TradingStatus{Halted: true, HaltReason: reason(Financial_Quotes_HaltNewsPending)},
+			Financial_Quotes_HaltNewsPending,
+			TradingStatus{Halted: true, HaltReason: reason(Financial_Quotes_HaltNewsPending)}, false),
+		Entry("SSR Activated - Restricted", TradingStatus{}, Financial_Quotes_ShortSalesRestrictionActivated,
+			TradingStatus{ShortSaleRestricted: true}, true),
+		Entry("SSR Deactivated - Not restricted", TradingStatus{ShortSaleRestricted: true},
+			Financial_Quotes_ShortSalesRestrictionDeactivated, TradingStatus{}, true),
+		Entry("NBB Entered, NBO Exited - Works", TradingStatus{NBOLimitState: true},
+			Financial_Quotes_NBBLimitStateEnteredNBOLimitStateExited, TradingStatus{NBBLimitState: true}, true),
+		Entry("NBB and NBO Exited - Works", TradingStatus{NBBLimitState: true, NBOLimitState: true},
+			Financial_Quotes_NBBAndNBOLimitStateExited, TradingStatus{}, true),
+		Entry("Unrelated indicator - No change", TradingStatus{}, Financial_Quotes_MarketImbalanceBuy,
+			TradingStatus{}, false))
+
+	// Tests that resetting the session clears the circuit breaker state, but leaves symbol-specific halts
+	It("TradingStatus.ResetSession - Works", func() {
+		status := TradingStatus{MWCBLevel: 3, MWCBHalted: true, Halted: true,
+			HaltReason: reason(Financial_Quotes_HaltRegulatoryConcern), ShortSaleRestricted: true}
+		Expect(status.ResetSession()).Should(Equal(TradingStatus{Halted: true,
+			HaltReason: reason(Financial_Quotes_HaltRegulatoryConcern), ShortSaleRestricted: true}))
+	})
+
+	// Tests that the tracker can resume trading after a level 3 circuit breaker once the session is reset
+	It("TradingStatusTracker.ResetSession - Works", func() {
+		tracker := NewTradingStatusTracker()
+		Expect(tracker.Apply("TSLA", Financial_Quotes_ShortSalesRestrictionActivated, NewUnixTimestamp(base, 0))).
+			ShouldNot(BeNil())
+		Expect(tracker.Apply("MSFT", Financial_Quotes_MWCBLevel3, NewUnixTimestamp(base, 0))).ShouldNot(BeNil())
+		Expect(tracker.Apply("AAPL", Financial_Quotes_MWCBLevel3, NewUnixTimestamp(base, 0))).Should(BeNil())
+		Expect(tracker.Apply("AAPL", Financial_Quotes_MWCBResume, NewUnixTimestamp(base+900, 0))).Should(BeNil())
+
+		tradable, reason := tracker.Tradable("AAPL")
+		Expect(tradable).Should(BeFalse())
+		Expect(reason).Should(Equal("market-wide circuit breaker level 3"))
+
+		// Reset the session on the following day and verify that the circuit breaker has been cleared
+		next := NewUnixTimestamp(base+86400, 0)
+		transitions := tracker.ResetSession(next)
+		Expect(transitions).Should(HaveLen(2))
+		Expect(transitions[0].Symbol).Should(Equal("MSFT"))
+		Expect(transitions[0].Indicator).Should(Equal(utils.NoValue[Financial_Quotes_Indicator]()))
+		Expect(transitions[0].Current).Should(Equal(TradingStatus{Timestamp: next}))
+		Expect(transitions[1].Symbol).Should(Equal("TSLA"))
+		Expect(transitions[1].Current).Should(Equal(TradingStatus{Timestamp: next, ShortSaleRestricted: true}))
+
+		tradable, _ = tracker.Tradable("AAPL")
+		Expect(tradable).Should(BeTrue())
+		Expect(tracker.Status("TSLA").ShortSaleRestricted).Should(BeTrue())
+		Expect(tracker.History("MSFT")).Should(HaveLen(2))
+		Expect(tracker.History("TSLA")).Should(HaveLen(3))
+		Expect(tracker.History("AAPL")).Should(BeEmpty())
+	})
+
+	// Tests that a market-wide circuit breaker reported for one symbol halts every symbol, including those
+	// the tracker has not received any indicators for, until it is resumed
+	It("TradingStatusTracker - Market-wide circuit breaker - Applies to every symbol", func() {
+		tracker := NewTradingStatusTracker()
+		Expect(tracker.Apply("AAPL", Financial_Quotes_NBBLimitStateEntered, NewUnixTimestamp(base, 0))).
+			ShouldNot(BeNil())
+		transition := tracker.Apply("MSFT", Financial_Quotes_MWCBLevel1, NewUnixTimestamp(base+60, 0))
+		Expect(transition.Symbol).Should(Equal("MSFT"))
+
+		for _, symbol := range []string{"AAPL", "MSFT", "TSLA"} {
+			tradable, reason := tracker.Tradable(symbol)
+			Expect(tradable).Should(BeFalse(), symbol)
+			Expect(reason).Should(Equal("market-wide circuit breaker level 1"), symbol)
+		}
+
+		history := tracker.History("AAPL")
+		Expect(history).Should(HaveLen(2))
+		Expect(history[1].Indicator).Should(Equal(Financial_Quotes_MWCBLevel1))
+		Expect(history[1].Current).Should(Equal(TradingStatus{Timestamp: NewUnixTimestamp(base+60, 0),
+			NBBLimitState: true, MWCBLevel: 1, MWCBHalted: true}))
+
+		// Resuming from any symbol should resume every symbol, keeping the level reached
+		Expect(tracker.Apply("TSLA", Financial_Quotes_MWCBResume, NewUnixTimestamp(base+960, 0))).ShouldNot(BeNil())
+		for _, symbol := range []string{"AAPL", "MSFT", "TSLA", "NVDA"} {
+			tradable, _ := tracker.Tradable(symbol)
+			Expect(tradable).Should(BeTrue(), symbol)
+			Expect(tracker.Status(symbol).MWCBLevel).Should(Equal(1), symbol)
+		}
+	})
+
+	// Tests that the tracker maintains the status and history of each symbol independently
+	It("TradingStatusTracker - Works", func() {
+		tracker := NewTradingStatusTracker()
+
+		// First, apply a series of indicators to two symbols
+		Expect(tracker.Apply("AAPL", Financial_Quotes_ShortSalesRestrictionActivated, NewUnixTimestamp(base, 0))).
+			ShouldNot(BeNil())
+		Expect(tracker.Apply("AAPL", Financial_Quotes_HaltNewsPending, NewUnixTimestamp(base+60, 0))).
+			ShouldNot(BeNil())
+		Expect(tracker.Apply("MSFT", Financial_Quotes_NBBLimitStateEntered, NewUnixTimestamp(base+90, 0))).
+			ShouldNot(BeNil())
+		Expect(tracker.Apply("AAPL", Financial_Quotes_HaltNewsPending, NewUnixTimestamp(base+120, 0))).
+			Should(BeNil())
+
+		// Next, verify that AAPL is halted and MSFT is tradable
+		tradable, reason := tracker.Tradable("AAPL")
+		Expect(tradable).Should(BeFalse())
+		Expect(reason).Should(Equal("trading halted (Halt: News Pending)"))
+		tradable, reason = tracker.Tradable("MSFT")
+		Expect(tradable).Should(BeTrue())
+		Expect(reason).Should(BeEmpty())
+
+		// Now, resume AAPL and verify that it is tradable but still short-sale restricted
+		transition := tracker.Apply("AAPL", Financial_Quotes_TradingResume, NewUnixTimestamp(base+300, 0))
+		Expect(transition.Symbol).Should(Equal("AAPL"))
+		Expect(transition.Previous.Halted).Should(BeTrue())
+		Expect(transition.Current.Halted).Should(BeFalse())
+		status := tracker.Status("AAPL")
+		Expect(status.ShortSaleRestricted).Should(BeTrue())
+		Expect(status.Timestamp).Should(Equal(NewUnixTimestamp(base+300, 0)))
+
+		// Finally, verify the history of each symbol
+		history := tracker.History("AAPL")
+		Expect(history).Should(HaveLen(3))
+		Expect(history[0].Indicator).Should(Equal(Financial_Quotes_ShortSalesRestrictionActivated))
+		Expect(history[1].Indicator).Should(Equal(Financial_Quotes_HaltNewsPending))
+		Expect(history[2].Indicator).Should(Equal(Financial_Quotes_TradingResume))
+		Expect(tracker.History("MSFT")).Should(HaveLen(1))
+		Expect(tracker.History("TSLA")).Should(BeEmpty())
+	})
+})
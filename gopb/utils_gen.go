@@ -0,0 +1,3107 @@
+// Code generated by gen-utils from aliases.yaml. DO NOT EDIT.
+
+package gopb
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/xefino/protobuf-gen-go/utils"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+)
+
+// providerAlternates contains alternative values for the Provider enum
+var providerAlternates = map[string]Provider{
+	"":        Provider_None,
+	"polygon": Provider_Polygon,
+}
+
+// ProviderAlternates contains alternative values for the Provider enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var ProviderAlternates = copyTable(providerAlternates)
+
+// providerMapping contains alternate names for the Provider enum
+var providerMapping = map[Provider]string{
+	Provider_None:    "",
+	Provider_Polygon: "polygon",
+}
+
+// ProviderMapping contains alternate names for the Provider enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var ProviderMapping = copyTable(providerMapping)
+
+// ProviderDescriptions contains the descriptions of the values of the Provider enum
+var ProviderDescriptions = map[Provider]string{
+	Provider_None:    "None, implying that the provider was not included, not that we're querying data that",
+	Provider_Polygon: "Data generated by Polygon",
+}
+
+// assetClassAlternates contains alternative values for the Financial.Common.AssetClass enum
+var assetClassAlternates = map[string]Financial_Common_AssetClass{
+	"":                 utils.NoValue[Financial_Common_AssetClass](),
+	"stocks":           Financial_Common_Stock,
+	"options":          Financial_Common_Option,
+	"crypto":           Financial_Common_Crypto,
+	"fx":               Financial_Common_ForeignExchange,
+	"Foreign Exchange": Financial_Common_ForeignExchange,
+	"otc":              Financial_Common_OverTheCounter,
+	"OTC":              Financial_Common_OverTheCounter,
+	"indices":          Financial_Common_Indices,
+	"index":            Financial_Common_Indices,
+	"Index":            Financial_Common_Indices,
+}
+
+// AssetClassAlternates contains alternative values for the Financial.Common.AssetClass enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var AssetClassAlternates = copyTable(assetClassAlternates)
+
+// assetClassMapping contains alternate names for the Financial.Common.AssetClass enum
+var assetClassMapping = map[Financial_Common_AssetClass]string{
+	Financial_Common_ForeignExchange: "Foreign Exchange",
+	Financial_Common_OverTheCounter:  "OTC",
+}
+
+// AssetClassMapping contains alternate names for the Financial.Common.AssetClass enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var AssetClassMapping = copyTable(assetClassMapping)
+
+// AssetClassDescriptions contains the descriptions of the values of the Financial.Common.AssetClass enum
+var AssetClassDescriptions = map[Financial_Common_AssetClass]string{
+	Financial_Common_Stock:           "Traditional equities (stocks)",
+	Financial_Common_Option:          "Options or derivatives (options)",
+	Financial_Common_Crypto:          "Crypto-currency assets (crypto)",
+	Financial_Common_ForeignExchange: "Foreign exchange (fx)",
+	Financial_Common_OverTheCounter:  "Over-the-counter (otc)",
+	Financial_Common_Indices:         "Indices",
+}
+
+// assetTypeAlternates contains alternative values for the Financial.Common.AssetType enum
+var assetTypeAlternates = map[string]Financial_Common_AssetType{
+	"":                        utils.NoValue[Financial_Common_AssetType](),
+	"CS":                      Financial_Common_CommonShare,
+	"Common Share":            Financial_Common_CommonShare,
+	"OS":                      Financial_Common_OrdinaryShare,
+	"Ordinary Share":          Financial_Common_OrdinaryShare,
+	"NYRS":                    Financial_Common_NewYorkRegistryShares,
+	"New York Registry Share": Financial_Common_NewYorkRegistryShares,
+	"ADRC":                    Financial_Common_AmericanDepositoryReceiptCommon,
+	"Common ADR":              Financial_Common_AmericanDepositoryReceiptCommon,
+	"ADRP":                    Financial_Common_AmericanDepositoryReceiptPreferred,
+	"Preferred ADR":           Financial_Common_AmericanDepositoryReceiptPreferred,
+	"ADRR":                    Financial_Common_AmericanDepositoryReceiptRights,
+	"ADR Right":               Financial_Common_AmericanDepositoryReceiptRights,
+	"ADRW":                    Financial_Common_AmericanDepositoryReceiptWarrants,
+	"ADR Warrant":             Financial_Common_AmericanDepositoryReceiptWarrants,
+	"GDR":                     Financial_Common_GlobalDepositoryReceipts,
+	"UNIT":                    Financial_Common_Unit,
+	"RIGHT":                   Financial_Common_Rights,
+	"Right":                   Financial_Common_Rights,
+	"PFD":                     Financial_Common_PreferredStock,
+	"Preferred Stock":         Financial_Common_PreferredStock,
+	"FUND":                    Financial_Common_Fund,
+	"SP":                      Financial_Common_StructuredProduct,
+	"Structured Product":      Financial_Common_StructuredProduct,
+	"WARRANT":                 Financial_Common_Warrant,
+	"INDEX":                   Financial_Common_Index,
+	"ETF":                     Financial_Common_ExchangeTradedFund,
+	"ETN":                     Financial_Common_ExchangeTradedNote,
+	"ETV":                     Financial_Common_ExchangeTradeVehicle,
+	"ETS":                     Financial_Common_SingleSecurityETF,
+	"BOND":                    Financial_Common_CorporateBond,
+	"Corporate Bond":          Financial_Common_CorporateBond,
+	"AGEN":                    Financial_Common_AgencyBond,
+	"Agency Bond":             Financial_Common_AgencyBond,
+	"EQLK":                    Financial_Common_EquityLinkedBond,
+	"Equity-Linked Bond":      Financial_Common_EquityLinkedBond,
+	"BASKET":                  Financial_Common_Basket,
+	"LT":                      Financial_Common_LiquidatingTrust,
+	"Liquidating Trust":       Financial_Common_LiquidatingTrust,
+	"OTHER":                   Financial_Common_Others,
+	"Other":                   Financial_Common_Others,
+	"None":                    Financial_Common_None,
+}
+
+// AssetTypeAlternates contains alternative values for the Financial.Common.AssetType enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var AssetTypeAlternates = copyTable(assetTypeAlternates)
+
+// assetTypeMapping contains alternate names for the Financial.Common.AssetType enum
+var assetTypeMapping = map[Financial_Common_AssetType]string{
+	Financial_Common_CommonShare:                        "Common Share",
+	Financial_Common_OrdinaryShare:                      "Ordinary Share",
+	Financial_Common_NewYorkRegistryShares:              "New York Registry Share",
+	Financial_Common_AmericanDepositoryReceiptCommon:    "Common ADR",
+	Financial_Common_AmericanDepositoryReceiptPreferred: "Preferred ADR",
+	Financial_Common_AmericanDepositoryReceiptRights:    "ADR Right",
+	Financial_Common_AmericanDepositoryReceiptWarrants:  "ADR Warrant",
+	Financial_Common_GlobalDepositoryReceipts:           "GDR",
+	Financial_Common_Rights:                             "Right",
+	Financial_Common_PreferredStock:                     "Preferred Stock",
+	Financial_Common_StructuredProduct:                  "Structured Product",
+	Financial_Common_ExchangeTradedFund:                 "ETF",
+	Financial_Common_ExchangeTradedNote:                 "ETN",
+	Financial_Common_ExchangeTradeVehicle:               "ETV",
+	Financial_Common_SingleSecurityETF:                  "ETS",
+	Financial_Common_CorporateBond:                      "Corporate Bond",
+	Financial_Common_AgencyBond:                         "Agency Bond",
+	Financial_Common_EquityLinkedBond:                   "Equity-Linked Bond",
+	Financial_Common_LiquidatingTrust:                   "Liquidating Trust",
+	Financial_Common_Others:                             "Other",
+	Financial_Common_None:                               "",
+}
+
+// AssetTypeMapping contains alternate names for the Financial.Common.AssetType enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var AssetTypeMapping = copyTable(assetTypeMapping)
+
+// localeAlternates contains alternative values for the Financial.Common.Locale enum
+var localeAlternates = map[string]Financial_Common_Locale{
+	"":       utils.NoValue[Financial_Common_Locale](),
+	"us":     Financial_Common_US,
+	"global": Financial_Common_Global,
+}
+
+// LocaleAlternates contains alternative values for the Financial.Common.Locale enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var LocaleAlternates = copyTable(localeAlternates)
+
+// LocaleDescriptions contains the descriptions of the values of the Financial.Common.Locale enum
+var LocaleDescriptions = map[Financial_Common_Locale]string{
+	Financial_Common_US:     "US-markets only (us)",
+	Financial_Common_Global: "Global markets (global)",
+}
+
+// TapeDescriptions contains the descriptions of the values of the Financial.Common.Tape enum
+var TapeDescriptions = map[Financial_Common_Tape]string{
+	Financial_Common_A: "NYSE-listed securities",
+	Financial_Common_B: "NYSE ARCA / NYSE American",
+	Financial_Common_C: "NASDAQ",
+}
+
+// dividendFrequencyAlternates contains alternative values for the Financial.Dividends.Frequency enum
+var dividendFrequencyAlternates = map[string]Financial_Dividends_Frequency{
+	"None": Financial_Dividends_NoFrequency,
+	"":     Financial_Dividends_NoFrequency,
+}
+
+// DividendFrequencyAlternates contains alternative values for the Financial.Dividends.Frequency enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var DividendFrequencyAlternates = copyTable(dividendFrequencyAlternates)
+
+// dividendFrequencyMapping contains alternate names for the Financial.Dividends.Frequency enum
+var dividendFrequencyMapping = map[Financial_Dividends_Frequency]string{
+	Financial_Dividends_NoFrequency: "",
+}
+
+// DividendFrequencyMapping contains alternate names for the Financial.Dividends.Frequency enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var DividendFrequencyMapping = copyTable(dividendFrequencyMapping)
+
+// DividendFrequencyDescriptions contains the descriptions of the values of the Financial.Dividends.Frequency enum
+var DividendFrequencyDescriptions = map[Financial_Dividends_Frequency]string{
+	Financial_Dividends_Invalid: "Code to hold invalid frequency values",
+}
+
+// exchangeTypeAlternates contains alternative values for the Financial.Exchanges.Type enum
+var exchangeTypeAlternates = map[string]Financial_Exchanges_Type{
+	"exchange": Financial_Exchanges_Exchange,
+}
+
+// ExchangeTypeAlternates contains alternative values for the Financial.Exchanges.Type enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var ExchangeTypeAlternates = copyTable(exchangeTypeAlternates)
+
+// optionContractTypeAlternates contains alternative values for the Financial.Options.ContractType enum
+var optionContractTypeAlternates = map[string]Financial_Options_ContractType{
+	"call":  Financial_Options_Call,
+	"put":   Financial_Options_Put,
+	"other": Financial_Options_Other,
+}
+
+// OptionContractTypeAlternates contains alternative values for the Financial.Options.ContractType enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var OptionContractTypeAlternates = copyTable(optionContractTypeAlternates)
+
+// optionExerciseStyleAlternates contains alternative values for the Financial.Options.ExerciseStyle enum
+var optionExerciseStyleAlternates = map[string]Financial_Options_ExerciseStyle{
+	"american": Financial_Options_American,
+	"european": Financial_Options_European,
+	"bermudan": Financial_Options_Bermudan,
+}
+
+// OptionExerciseStyleAlternates contains alternative values for the Financial.Options.ExerciseStyle enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var OptionExerciseStyleAlternates = copyTable(optionExerciseStyleAlternates)
+
+// optionUnderlyingTypeAlternates contains alternative values for the Financial.Options.UnderlyingType enum
+var optionUnderlyingTypeAlternates = map[string]Financial_Options_UnderlyingType{
+	"equity":   Financial_Options_Equity,
+	"currency": Financial_Options_Currency,
+}
+
+// OptionUnderlyingTypeAlternates contains alternative values for the Financial.Options.UnderlyingType enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var OptionUnderlyingTypeAlternates = copyTable(optionUnderlyingTypeAlternates)
+
+// quoteConditionAlternates contains alternative values for the Financial.Quotes.Condition enum
+var quoteConditionAlternates = map[string]Financial_Quotes_Condition{
+	"-1":                                Financial_Quotes_Invalid,
+	"Regular, Two-Sided Open":           Financial_Quotes_RegularTwoSidedOpen,
+	"Regular, One-Sided Open":           Financial_Quotes_RegularOneSidedOpen,
+	"Slow Ask":                          Financial_Quotes_SlowAsk,
+	"Slow Bid":                          Financial_Quotes_SlowBid,
+	"Slow Bid, Ask":                     Financial_Quotes_SlowBidAsk,
+	"Slow Due, LRP Bid":                 Financial_Quotes_SlowDueLRPBid,
+	"Slow Due, LRP Ask":                 Financial_Quotes_SlowDueLRPAsk,
+	"Slow Due, NYSE LRP":                Financial_Quotes_SlowDueNYSELRP,
+	"Slow Due Set, Slow List, Bid, Ask": Financial_Quotes_SlowDueSetSlowListBidAsk,
+	"Manual Ask, Automated Bid":         Financial_Quotes_ManualAskAutomatedBid,
+	"Manual Bid, Automated Ask":         Financial_Quotes_ManualBidAutomatedAsk,
+	"Manual Bid and Ask":                Financial_Quotes_ManualBidAndAsk,
+	"Fast Trading":                      Financial_Quotes_FastTrading,
+	"Tading Range Indicated":            Financial_Quotes_TradingRangeIndicated,
+	"Market-Maker Quotes Closed":        Financial_Quotes_MarketMakerQuotesClosed,
+	"Non-Firm":                          Financial_Quotes_NonFirm,
+	"News Dissemination":                Financial_Quotes_NewsDissemination,
+	"Order Influx":                      Financial_Quotes_OrderInflux,
+	"Order Imbalance":                   Financial_Quotes_OrderImbalance,
+	"Due to Related Security, News Dissemination":    Financial_Quotes_DueToRelatedSecurityNewsDissemination,
+	"Due to Related Security, News Pending":          Financial_Quotes_DueToRelatedSecurityNewsPending,
+	"Additional Information":                         Financial_Quotes_AdditionalInformation,
+	"News Pending":                                   Financial_Quotes_NewsPending,
+	"Additional Information Due to Related Security": Financial_Quotes_AdditionalInformationDueToRelatedSecurity,
+	"Due to Related Security":                        Financial_Quotes_DueToRelatedSecurity,
+	"In View of Common":                              Financial_Quotes_InViewOfCommon,
+	"Equipment Changeover":                           Financial_Quotes_EquipmentChangeover,
+	"No Open, No Response":                           Financial_Quotes_NoOpenNoResponse,
+	"Sub-Penny Trading":                              Financial_Quotes_SubPennyTrading,
+	"Automated Bid; No Offer, No Bid":                Financial_Quotes_AutomatedBidNoOfferNoBid,
+	"LULD Price Band":                                Financial_Quotes_LULDPriceBand,
+	"Market-Wide Circuit Breaker, Level 1":           Financial_Quotes_MarketWideCircuitBreakerLevel1,
+	"Market-Wide Circuit Breaker, Level 2":           Financial_Quotes_MarketWideCircuitBreakerLevel2,
+	"Market-Wide Circuit Breaker, Level 3":           Financial_Quotes_MarketWideCircuitBreakerLevel3,
+	"Republished LULD Price Band":                    Financial_Quotes_RepublishedLULDPriceBand,
+	"On-Demand Auction":                              Financial_Quotes_OnDemandAuction,
+	"Cash-Only Settlement":                           Financial_Quotes_CashOnlySettlement,
+	"Next-Day Settlement":                            Financial_Quotes_NextDaySettlement,
+	"LULD Trading Pause":                             Financial_Quotes_LULDTradingPause,
+	"Slow Due LRP, Bid, Ask":                         Financial_Quotes_SlowDueLRPBidAsk,
+	"Cancel":                                         Financial_Quotes_Cancel,
+	"Corrected Price":                                Financial_Quotes_CorrectedPrice,
+	"SIP-Generated":                                  Financial_Quotes_SIPGenerated,
+	"Unknown":                                        Financial_Quotes_Unknown,
+	"Crossed Market":                                 Financial_Quotes_CrossedMarket,
+	"Locked Market":                                  Financial_Quotes_LockedMarket,
+	"Depth on Offer Side":                            Financial_Quotes_DepthOnOfferSide,
+	"Depth on Bid Side":                              Financial_Quotes_DepthOnBidSide,
+	"Depth on Bid and Offer":                         Financial_Quotes_DepthOnBidAndOffer,
+	"Pre-Opening Indication":                         Financial_Quotes_PreOpeningIndication,
+	"Syndicate Bid":                                  Financial_Quotes_SyndicateBid,
+	"Pre-Syndicate Bid":                              Financial_Quotes_PreSyndicateBid,
+	"Penalty Bid":                                    Financial_Quotes_PenaltyBid,
+	"CQS-Generated":                                  Financial_Quotes_CQSGenerated,
+	"CTA:A":                                          Financial_Quotes_SlowAsk,
+	"CTA:B":                                          Financial_Quotes_SlowBid,
+	"CTA:C":                                          Financial_Quotes_Closing,
+	"CTA:D":                                          Financial_Quotes_NewsDissemination,
+	"CTA:E":                                          Financial_Quotes_SlowDueLRPBid,
+	"CTA:F":                                          Financial_Quotes_SlowDueLRPAsk,
+	"CTA:G":                                          Financial_Quotes_TradingRangeIndicated,
+	"CTA:H":                                          Financial_Quotes_SlowBidAsk,
+	"CTA:I":                                          Financial_Quotes_OrderImbalance,
+	"CTA:J":                                          Financial_Quotes_DueToRelatedSecurityNewsDissemination,
+	"CTA:K":                                          Financial_Quotes_DueToRelatedSecurityNewsPending,
+	"CTA:M":                                          Financial_Quotes_AdditionalInformation,
+	"CTA:N":                                          Financial_Quotes_NonFirm,
+	"CTA:O":                                          Financial_Quotes_Opening,
+	"CTA:P":                                          Financial_Quotes_NewsPending,
+	"CTA:Q":                                          Financial_Quotes_AdditionalInformationDueToRelatedSecurity,
+	"CTA:R":                                          Financial_Quotes_Regular,
+	"CTA:S":                                          Financial_Quotes_DueToRelatedSecurity,
+	"CTA:T":                                          Financial_Quotes_Resume,
+	"CTA:U":                                          Financial_Quotes_SlowDueLRPBidAsk,
+	"CTA:V":                                          Financial_Quotes_InViewOfCommon,
+	"CTA:W":                                          Financial_Quotes_SlowDueSetSlowListBidAsk,
+	"CTA:X":                                          Financial_Quotes_EquipmentChangeover,
+	"CTA:Z":                                          Financial_Quotes_NoOpenNoResponse,
+	"CTA:1":                                          Financial_Quotes_MarketWideCircuitBreakerLevel1,
+	"CTA:2":                                          Financial_Quotes_MarketWideCircuitBreakerLevel2,
+	"CTA:3":                                          Financial_Quotes_MarketWideCircuitBreakerLevel3,
+	"CTA:4":                                          Financial_Quotes_OnDemandAuction,
+	"UTP:A":                                          Financial_Quotes_ManualAskAutomatedBid,
+	"UTP:B":                                          Financial_Quotes_ManualBidAutomatedAsk,
+	"UTP:F":                                          Financial_Quotes_FastTrading,
+	"UTP:H":                                          Financial_Quotes_ManualBidAndAsk,
+	"UTP:I":                                          Financial_Quotes_OrderImbalance,
+	"UTP:L":                                          Financial_Quotes_MarketMakerQuotesClosed,
+	"UTP:N":                                          Financial_Quotes_NonFirm,
+	"UTP:O":                                          Financial_Quotes_Opening,
+	"UTP:R":                                          Financial_Quotes_Regular,
+	"UTP:X":                                          Financial_Quotes_OrderInflux,
+	"UTP:Y":                                          Financial_Quotes_AutomatedBidNoOfferNoBid,
+	"UTP:Z":                                          Financial_Quotes_NoOpenNoResponse,
+	"UTP:4":                                          Financial_Quotes_OnDemandAuction,
+}
+
+// QuoteConditionAlternates contains alternative values for the Financial.Quotes.Condition enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var QuoteConditionAlternates = copyTable(quoteConditionAlternates)
+
+// quoteConditionMapping contains alternate names for the Financial.Quotes.Condition enum
+var quoteConditionMapping = map[Financial_Quotes_Condition]string{
+	Financial_Quotes_RegularTwoSidedOpen:                       "Regular, Two-Sided Open",
+	Financial_Quotes_RegularOneSidedOpen:                       "Regular, One-Sided Open",
+	Financial_Quotes_SlowAsk:                                   "Slow Ask",
+	Financial_Quotes_SlowBid:                                   "Slow Bid",
+	Financial_Quotes_SlowBidAsk:                                "Slow Bid, Ask",
+	Financial_Quotes_SlowDueLRPBid:                             "Slow Due, LRP Bid",
+	Financial_Quotes_SlowDueLRPAsk:                             "Slow Due, LRP Ask",
+	Financial_Quotes_SlowDueNYSELRP:                            "Slow Due, NYSE LRP",
+	Financial_Quotes_SlowDueSetSlowListBidAsk:                  "Slow Due Set, Slow List, Bid, Ask",
+	Financial_Quotes_ManualAskAutomatedBid:                     "Manual Ask, Automated Bid",
+	Financial_Quotes_ManualBidAutomatedAsk:                     "Manual Bid, Automated Ask",
+	Financial_Quotes_ManualBidAndAsk:                           "Manual Bid and Ask",
+	Financial_Quotes_FastTrading:                               "Fast Trading",
+	Financial_Quotes_TradingRangeIndicated:                     "Tading Range Indicated",
+	Financial_Quotes_MarketMakerQuotesClosed:                   "Market-Maker Quotes Closed",
+	Financial_Quotes_NonFirm:                                   "Non-Firm",
+	Financial_Quotes_NewsDissemination:                         "News Dissemination",
+	Financial_Quotes_OrderInflux:                               "Order Influx",
+	Financial_Quotes_OrderImbalance:                            "Order Imbalance",
+	Financial_Quotes_DueToRelatedSecurityNewsDissemination:     "Due to Related Security, News Dissemination",
+	Financial_Quotes_DueToRelatedSecurityNewsPending:           "Due to Related Security, News Pending",
+	Financial_Quotes_AdditionalInformation:                     "Additional Information",
+	Financial_Quotes_NewsPending:                               "News Pending",
+	Financial_Quotes_AdditionalInformationDueToRelatedSecurity: "Additional Information Due to Related Security",
+	Financial_Quotes_DueToRelatedSecurity:                      "Due to Related Security",
+	Financial_Quotes_InViewOfCommon:                            "In View of Common",
+	Financial_Quotes_EquipmentChangeover:                       "Equipment Changeover",
+	Financial_Quotes_NoOpenNoResponse:                          "No Open, No Response",
+	Financial_Quotes_SubPennyTrading:                           "Sub-Penny Trading",
+	Financial_Quotes_AutomatedBidNoOfferNoBid:                  "Automated Bid; No Offer, No Bid",
+	Financial_Quotes_LULDPriceBand:                             "LULD Price Band",
+	Financial_Quotes_MarketWideCircuitBreakerLevel1:            "Market-Wide Circuit Breaker, Level 1",
+	Financial_Quotes_MarketWideCircuitBreakerLevel2:            "Market-Wide Circuit Breaker, Level 2",
+	Financial_Quotes_MarketWideCircuitBreakerLevel3:            "Market-Wide Circuit Breaker, Level 3",
+	Financial_Quotes_RepublishedLULDPriceBand:                  "Republished LULD Price Band",
+	Financial_Quotes_OnDemandAuction:                           "On-Demand Auction",
+	Financial_Quotes_CashOnlySettlement:                        "Cash-Only Settlement",
+	Financial_Quotes_NextDaySettlement:                         "Next-Day Settlement",
+	Financial_Quotes_LULDTradingPause:                          "LULD Trading Pause",
+	Financial_Quotes_SlowDueLRPBidAsk:                          "Slow Due LRP, Bid, Ask",
+	Financial_Quotes_CorrectedPrice:                            "Corrected Price",
+	Financial_Quotes_SIPGenerated:                              "SIP-Generated",
+	Financial_Quotes_CrossedMarket:                             "Crossed Market",
+	Financial_Quotes_LockedMarket:                              "Locked Market",
+	Financial_Quotes_DepthOnOfferSide:                          "Depth on Offer Side",
+	Financial_Quotes_DepthOnBidSide:                            "Depth on Bid Side",
+	Financial_Quotes_DepthOnBidAndOffer:                        "Depth on Bid and Offer",
+	Financial_Quotes_PreOpeningIndication:                      "Pre-Opening Indication",
+	Financial_Quotes_SyndicateBid:                              "Syndicate Bid",
+	Financial_Quotes_PreSyndicateBid:                           "Pre-Syndicate Bid",
+	Financial_Quotes_PenaltyBid:                                "Penalty Bid",
+	Financial_Quotes_CQSGenerated:                              "CQS-Generated",
+}
+
+// QuoteConditionMapping contains alternate names for the Financial.Quotes.Condition enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var QuoteConditionMapping = copyTable(quoteConditionMapping)
+
+// quoteConditionCodes contains the codes used for the values of the Financial.Quotes.Condition enum by each of the sources
+// that disseminate them. Each code is also included in the alternates, prefixed by the name of its source
+var quoteConditionCodes = map[string]map[string]Financial_Quotes_Condition{
+	"CTA": {
+		"A": Financial_Quotes_SlowAsk,
+		"B": Financial_Quotes_SlowBid,
+		"C": Financial_Quotes_Closing,
+		"D": Financial_Quotes_NewsDissemination,
+		"E": Financial_Quotes_SlowDueLRPBid,
+		"F": Financial_Quotes_SlowDueLRPAsk,
+		"G": Financial_Quotes_TradingRangeIndicated,
+		"H": Financial_Quotes_SlowBidAsk,
+		"I": Financial_Quotes_OrderImbalance,
+		"J": Financial_Quotes_DueToRelatedSecurityNewsDissemination,
+		"K": Financial_Quotes_DueToRelatedSecurityNewsPending,
+		"M": Financial_Quotes_AdditionalInformation,
+		"N": Financial_Quotes_NonFirm,
+		"O": Financial_Quotes_Opening,
+		"P": Financial_Quotes_NewsPending,
+		"Q": Financial_Quotes_AdditionalInformationDueToRelatedSecurity,
+		"R": Financial_Quotes_Regular,
+		"S": Financial_Quotes_DueToRelatedSecurity,
+		"T": Financial_Quotes_Resume,
+		"U": Financial_Quotes_SlowDueLRPBidAsk,
+		"V": Financial_Quotes_InViewOfCommon,
+		"W": Financial_Quotes_SlowDueSetSlowListBidAsk,
+		"X": Financial_Quotes_EquipmentChangeover,
+		"Z": Financial_Quotes_NoOpenNoResponse,
+		"1": Financial_Quotes_MarketWideCircuitBreakerLevel1,
+		"2": Financial_Quotes_MarketWideCircuitBreakerLevel2,
+		"3": Financial_Quotes_MarketWideCircuitBreakerLevel3,
+		"4": Financial_Quotes_OnDemandAuction,
+	},
+	"UTP": {
+		"A": Financial_Quotes_ManualAskAutomatedBid,
+		"B": Financial_Quotes_ManualBidAutomatedAsk,
+		"F": Financial_Quotes_FastTrading,
+		"H": Financial_Quotes_ManualBidAndAsk,
+		"I": Financial_Quotes_OrderImbalance,
+		"L": Financial_Quotes_MarketMakerQuotesClosed,
+		"N": Financial_Quotes_NonFirm,
+		"O": Financial_Quotes_Opening,
+		"R": Financial_Quotes_Regular,
+		"X": Financial_Quotes_OrderInflux,
+		"Y": Financial_Quotes_AutomatedBidNoOfferNoBid,
+		"Z": Financial_Quotes_NoOpenNoResponse,
+		"4": Financial_Quotes_OnDemandAuction,
+	},
+}
+
+// QuoteConditionDescriptions contains the descriptions of the values of the Financial.Quotes.Condition enum
+var QuoteConditionDescriptions = map[Financial_Quotes_Condition]string{
+	Financial_Quotes_Regular:                                   "Regular",
+	Financial_Quotes_RegularTwoSidedOpen:                       "Regular Two-Sided Open",
+	Financial_Quotes_RegularOneSidedOpen:                       "Regular One-Sided Open",
+	Financial_Quotes_SlowAsk:                                   "Slow Ask",
+	Financial_Quotes_SlowBid:                                   "Slow Bid",
+	Financial_Quotes_SlowBidAsk:                                "Slow Bid Ask",
+	Financial_Quotes_SlowDueLRPBid:                             "Slow Due LRP Bid",
+	Financial_Quotes_SlowDueLRPAsk:                             "Slow Due LRP Ask",
+	Financial_Quotes_SlowDueNYSELRP:                            "Slow Due NYSE LRP",
+	Financial_Quotes_SlowDueSetSlowListBidAsk:                  "Slow Due Set, Slow List Bid Ask",
+	Financial_Quotes_ManualAskAutomatedBid:                     "Manual Ask Automated Bid",
+	Financial_Quotes_ManualBidAutomatedAsk:                     "Manual Bid Automated Ask",
+	Financial_Quotes_ManualBidAndAsk:                           "Manual Bid and Ask",
+	Financial_Quotes_Opening:                                   "Opening",
+	Financial_Quotes_Closing:                                   "Closing",
+	Financial_Quotes_Closed:                                    "Closed",
+	Financial_Quotes_Resume:                                    "Resume",
+	Financial_Quotes_FastTrading:                               "Fast Trading",
+	Financial_Quotes_TradingRangeIndicated:                     "Trading Range Indication",
+	Financial_Quotes_MarketMakerQuotesClosed:                   "Market-Maker Quotes Closed",
+	Financial_Quotes_NonFirm:                                   "Non-Firm",
+	Financial_Quotes_NewsDissemination:                         "News Dissemination",
+	Financial_Quotes_OrderInflux:                               "Order Influx",
+	Financial_Quotes_OrderImbalance:                            "Order Imbalance",
+	Financial_Quotes_DueToRelatedSecurityNewsDissemination:     "Due to Related Security News Dissemination",
+	Financial_Quotes_DueToRelatedSecurityNewsPending:           "Due to Related Security News Pending",
+	Financial_Quotes_AdditionalInformation:                     "Additional Information",
+	Financial_Quotes_NewsPending:                               "News Pending",
+	Financial_Quotes_AdditionalInformationDueToRelatedSecurity: "Additional Information Due to Related Security",
+	Financial_Quotes_DueToRelatedSecurity:                      "Due to Related Security",
+	Financial_Quotes_InViewOfCommon:                            "In View of Common",
+	Financial_Quotes_EquipmentChangeover:                       "Equipment Change-over",
+	Financial_Quotes_NoOpenNoResponse:                          "No Open, No Response",
+	Financial_Quotes_SubPennyTrading:                           "Sub-Penny Trading",
+	Financial_Quotes_AutomatedBidNoOfferNoBid:                  "Automated No Offer, No Bid",
+	Financial_Quotes_LULDPriceBand:                             "LULD Price Band",
+	Financial_Quotes_MarketWideCircuitBreakerLevel1:            "Market-Wide Circuit Breaker 1",
+	Financial_Quotes_MarketWideCircuitBreakerLevel2:            "Market-Wide Circuit Breaker 2",
+	Financial_Quotes_MarketWideCircuitBreakerLevel3:            "Market-Wide Circuit Breaker 3",
+	Financial_Quotes_RepublishedLULDPriceBand:                  "Republished LULD Price Band",
+	Financial_Quotes_OnDemandAuction:                           "On-Demand Auction",
+	Financial_Quotes_CashOnlySettlement:                        "Cash-Only Settlement",
+	Financial_Quotes_NextDaySettlement:                         "Next-Day Settlement",
+	Financial_Quotes_LULDTradingPause:                          "LULD Trading Pause",
+	Financial_Quotes_SlowDueLRPBidAsk:                          "Slow Due LRP Bid/Ask",
+	Financial_Quotes_Cancel:                                    "Cancel",
+	Financial_Quotes_CorrectedPrice:                            "Corrected Price",
+	Financial_Quotes_SIPGenerated:                              "SIP-Generated",
+	Financial_Quotes_Unknown:                                   "Unknown",
+	Financial_Quotes_CrossedMarket:                             "Crossed Market",
+	Financial_Quotes_LockedMarket:                              "Locked Market",
+	Financial_Quotes_DepthOnOfferSide:                          "Depth on Offer Side",
+	Financial_Quotes_DepthOnBidSide:                            "Depth on Bid Side",
+	Financial_Quotes_DepthOnBidAndOffer:                        "Depth on Bid and Offer",
+	Financial_Quotes_PreOpeningIndication:                      "Pre-Opening Indication",
+	Financial_Quotes_SyndicateBid:                              "Syndicate Bid",
+	Financial_Quotes_PreSyndicateBid:                           "Pre-Syndicate Bid",
+	Financial_Quotes_PenaltyBid:                                "Penalty Bid",
+	Financial_Quotes_CQSGenerated:                              "CQS Generated",
+	Financial_Quotes_Invalid:                                   "Invalid (actually value is -1 but that's not valid for protobuf enums)",
+}
+
+// quoteIndicatorAlternates contains alternative values for the Financial.Quotes.Indicator enum
+var quoteIndicatorAlternates = map[string]Financial_Quotes_Indicator{
+	"NBB and/or NBO are Executable":                                 Financial_Quotes_NBBNBOExecutable,
+	"NBB below Lower Band":                                          Financial_Quotes_NBBBelowLowerBand,
+	"NBO above Upper Band":                                          Financial_Quotes_NBOAboveUpperBand,
+	"NBB below Lower Band and NBO above Upper Band":                 Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand,
+	"NBB equals Upper Band":                                         Financial_Quotes_NBBEqualsUpperBand,
+	"NBO equals Lower Band":                                         Financial_Quotes_NBOEqualsLowerBand,
+	"NBB equals Upper Band and NBO above Upper Band":                Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand,
+	"NBB below Lower Band and NBO equals Lower Band":                Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand,
+	"Bid Price above Upper Limit Price Band":                        Financial_Quotes_BidPriceAboveUpperLimitPriceBand,
+	"Offer Price below Lower Limit Price Band":                      Financial_Quotes_OfferPriceBelowLowerLimitPriceBand,
+	"Bid and Offer outside Price Band":                              Financial_Quotes_BidAndOfferOutsidePriceBand,
+	"Opening Update":                                                Financial_Quotes_OpeningUpdate,
+	"Intra-Day Update":                                              Financial_Quotes_IntraDayUpdate,
+	"Restated Value":                                                Financial_Quotes_RestatedValue,
+	"Suspended during Trading Halt or Trading Pause":                Financial_Quotes_SuspendedDuringTradingHalt,
+	"Re-Opening Update":                                             Financial_Quotes_ReOpeningUpdate,
+	"Outside Price Band Rule Hours":                                 Financial_Quotes_OutsidePriceBandRuleHours,
+	"Auction Extension (Auction Collar Message)":                    Financial_Quotes_AuctionExtension,
+	"LULD Price Band":                                               Financial_Quotes_LULDPriceBandInd,
+	"Republished LULD Price Band":                                   Financial_Quotes_RepublishedLULDPriceBandInd,
+	"NBB Limit State Entered":                                       Financial_Quotes_NBBLimitStateEntered,
+	"NBB Limit State Exited":                                        Financial_Quotes_NBBLimitStateExited,
+	"NBO Limit State Entered":                                       Financial_Quotes_NBOLimitStateEntered,
+	"NBO Limit State Exited":                                        Financial_Quotes_NBOLimitStateExited,
+	"NBB and NBO Limit State Entered":                               Financial_Quotes_NBBAndNBOLimitStateEntered,
+	"NBB and NBO Limit State Exited":                                Financial_Quotes_NBBAndNBOLimitStateExited,
+	"NBB Limit State Entered and NBO Limit State Exited":            Financial_Quotes_NBBLimitStateEnteredNBOLimitStateExited,
+	"NBB Limit State Exited and NBO Limit State Entered":            Financial_Quotes_NBBLimitStateExitedNBOLimitStateEntered,
+	"Deficient - Below Listing Requirements":                        Financial_Quotes_Deficient,
+	"Delinquent - Late Filing":                                      Financial_Quotes_Delinquent,
+	"Bankrupt and Deficient":                                        Financial_Quotes_BankruptAndDeficient,
+	"Bankrupt and Delinquent":                                       Financial_Quotes_BankruptAndDelinquent,
+	"Deficient and Delinquent":                                      Financial_Quotes_DeficientAndDelinquent,
+	"Deficient, Delinquent, and Bankrupt":                           Financial_Quotes_DeficientDeliquentBankrupt,
+	"Creations Suspended":                                           Financial_Quotes_CreationsSuspended,
+	"Redemptions Suspended":                                         Financial_Quotes_RedemptionsSuspended,
+	"Creations and/or Redemptions Suspended":                        Financial_Quotes_CreationsRedemptionsSuspended,
+	"Normal Trading":                                                Financial_Quotes_NormalTrading,
+	"Opening Delay":                                                 Financial_Quotes_OpeningDelay,
+	"Trading Halt":                                                  Financial_Quotes_TradingHalt,
+	"Resume":                                                        Financial_Quotes_TradingResume,
+	"No Open / No Resume":                                           Financial_Quotes_NoOpenNoResume,
+	"Price Indication":                                              Financial_Quotes_PriceIndication,
+	"Trading Range Indication":                                      Financial_Quotes_TradingRangeIndication,
+	"Market Imbalance Buy":                                          Financial_Quotes_MarketImbalanceBuy,
+	"Market Imbalance Sell":                                         Financial_Quotes_MarketImbalanceSell,
+	"Market On-Close Imbalance Buy":                                 Financial_Quotes_MarketOnCloseImbalanceBuy,
+	"Market On Close Imbalance Sell":                                Financial_Quotes_MarketOnCloseImbalanceSell,
+	"No Market Imbalance":                                           Financial_Quotes_NoMarketImbalance,
+	"No Market, On-Close Imbalance":                                 Financial_Quotes_NoMarketOnCloseImbalance,
+	"Short Sale Restriction":                                        Financial_Quotes_ShortSaleRestriction,
+	"Limit Up-Limit Down":                                           Financial_Quotes_LimitUpLimitDown,
+	"Quotation Resumption":                                          Financial_Quotes_QuotationResumption,
+	"Trading Resumption":                                            Financial_Quotes_TradingResumption,
+	"Volatility Trading Pause":                                      Financial_Quotes_VolatilityTradingPause,
+	"Halt: News Pending":                                            Financial_Quotes_HaltNewsPending,
+	"Update: News Dissemination":                                    Financial_Quotes_UpdateNewsDissemination,
+	"Halt: Single Stock Trading Pause In Affect":                    Financial_Quotes_HaltSingleStockTradingPause,
+	"Halt: Regulatory Extraordinary Market Activity":                Financial_Quotes_HaltRegulatoryExtraordinaryMarketActivity,
+	"Halt: ETF":                                                     Financial_Quotes_HaltETF,
+	"Halt: Information Requested":                                   Financial_Quotes_HaltInformationRequested,
+	"Halt: Exchange Non-Compliance":                                 Financial_Quotes_HaltExchangeNonCompliance,
+	"Halt: Filings Not Current":                                     Financial_Quotes_HaltFilingsNotCurrent,
+	"Halt: SEC Trading Suspension":                                  Financial_Quotes_HaltSECTradingSuspension,
+	"Halt: Regulatory Concern":                                      Financial_Quotes_HaltRegulatoryConcern,
+	"Halt: Market Operations":                                       Financial_Quotes_HaltMarketOperations,
+	"IPO Security: Not Yet Trading":                                 Financial_Quotes_IPOSecurityNotYetTrading,
+	"Halt: Corporate Action":                                        Financial_Quotes_HaltCorporateAction,
+	"Quotation Not Available":                                       Financial_Quotes_QuotationNotAvailable,
+	"Halt: Volatility Trading Pause":                                Financial_Quotes_HaltVolatilityTradingPause,
+	"Halt: Volatility Trading Pause - Straddle Condition":           Financial_Quotes_HaltVolatilityTradingPauseStraddleCondition,
+	"Update: News and Resumption Times":                             Financial_Quotes_UpdateNewsAndResumptionTimes,
+	"Halt: Single Stock Trading Pause - Quotes Only":                Financial_Quotes_HaltSingleStockTradingPauseQuotesOnly,
+	"Resume: Qualification Issues Reviewed / Resolved":              Financial_Quotes_ResumeQualificationIssuesReviewedResolved,
+	"Resume: Filing Requirements Satisfied / Resolved":              Financial_Quotes_ResumeFilingRequirementsSatisfiedResolved,
+	"Resume: News Not Forthcoming":                                  Financial_Quotes_ResumeNewsNotForthcoming,
+	"Resume: Qualifications - Maintenance Requirements Met":         Financial_Quotes_ResumeQualificationsMaintRequirementsMet,
+	"Resume: Qualifications - Filings Met":                          Financial_Quotes_ResumeQualificationsFilingsMet,
+	"Resume: Regulatory Auth":                                       Financial_Quotes_ResumeRegulatoryAuth,
+	"New Issue Available":                                           Financial_Quotes_NewIssueAvailable,
+	"Issue Available":                                               Financial_Quotes_IssueAvailable,
+	"MWCB - Carry from Previous Day":                                Financial_Quotes_MWCBCarryFromPreviousDay,
+	"MWCB - Resume":                                                 Financial_Quotes_MWCBResume,
+	"IPO Security: Released for Quotation":                          Financial_Quotes_IPOSecurityReleasedForQuotation,
+	"IPO Security: Positioning Window Extension":                    Financial_Quotes_IPOSecurityPositioningWindowExtension,
+	"MWCB - Level 1":                                                Financial_Quotes_MWCBLevel1,
+	"MWCB - Level 2":                                                Financial_Quotes_MWCBLevel2,
+	"MWCB - Level 3":                                                Financial_Quotes_MWCBLevel3,
+	"Halt: Sub-Penny Trading":                                       Financial_Quotes_HaltSubPennyTrading,
+	"Order Imbalance":                                               Financial_Quotes_OrderImbalanceInd,
+	"LULD Trading Paused":                                           Financial_Quotes_LULDTradingPaused,
+	"Security Status: None":                                         Financial_Quotes_NONE,
+	"Short Sales Restriction Activated":                             Financial_Quotes_ShortSalesRestrictionActivated,
+	"Short Sales Restriction Continued":                             Financial_Quotes_ShortSalesRestrictionContinued,
+	"Short Sales Restriction Deactivated":                           Financial_Quotes_ShortSalesRestrictionDeactivated,
+	"Short Sales Restriction in Effect":                             Financial_Quotes_ShortSalesRestrictionInEffect,
+	"Short Sales Restriction Max":                                   Financial_Quotes_ShortSalesRestrictionMax,
+	"NBBO_NO_CHANGE":                                                Financial_Quotes_NBBONoChange,
+	"NBBO: No Change":                                               Financial_Quotes_NBBONoChange,
+	"NBBO_QUOTE_IS_NBBO":                                            Financial_Quotes_NBBOQuoteIsNBBO,
+	"NBBO: Quote is NBBO":                                           Financial_Quotes_NBBOQuoteIsNBBO,
+	"NBBO_NO_BB_NO_BO":                                              Financial_Quotes_NBBONoBBNoBO,
+	"NBBO: No BB, No BO":                                            Financial_Quotes_NBBONoBBNoBO,
+	"NBBO_BB_BO_SHORT_APPENDAGE":                                    Financial_Quotes_NBBOBBBOShortAppendage,
+	"NBBO: BB / BO Short Appendage":                                 Financial_Quotes_NBBOBBBOShortAppendage,
+	"NBBO_BB_BO_LONG_APPENDAGE":                                     Financial_Quotes_NBBOBBBOLongAppendage,
+	"NBBO: BB / BO Long Appendage":                                  Financial_Quotes_NBBOBBBOLongAppendage,
+	"HELD_TRADE_NOT_LAST_SALE_AND_NOT_ON_CONSOLIDATED":              Financial_Quotes_HeldTradeNotLastSaleNotConsolidated,
+	"Held Trade not Last Sale, not Consolidated":                    Financial_Quotes_HeldTradeNotLastSaleNotConsolidated,
+	"HELD_TRADE_LAST_SALE_BUT_NOT_ON_CONSOLIDATED":                  Financial_Quotes_HeldTradeLastSaleButNotConsolidated,
+	"Held Trade Last Sale but not Consolidated":                     Financial_Quotes_HeldTradeLastSaleButNotConsolidated,
+	"HELD_TRADE_LAST_SALE_AND_ON_CONSOLIDATED":                      Financial_Quotes_HeldTradeLastSaleAndConsolidated,
+	"Held Trade Last Sale and Consolidated":                         Financial_Quotes_HeldTradeLastSaleAndConsolidated,
+	"RETAIL_INTEREST_ON_BID":                                        Financial_Quotes_RetailInterestOnBid,
+	"Retail Interest on Bid":                                        Financial_Quotes_RetailInterestOnBid,
+	"RETAIL_INTEREST_ON_ASK":                                        Financial_Quotes_RetailInterestOnAsk,
+	"Retail Interest on Ask":                                        Financial_Quotes_RetailInterestOnAsk,
+	"RETAIL_INTEREST_ON_BID_AND_ASK":                                Financial_Quotes_RetailInterestOnBidAndAsk,
+	"Retail Interest on Bid and Ask":                                Financial_Quotes_RetailInterestOnBidAndAsk,
+	"FINRA_BBO_NO_CHANGE":                                           Financial_Quotes_FinraBBONoChange,
+	"FINRA BBO: No Change":                                          Financial_Quotes_FinraBBONoChange,
+	"FINRA_BBO_DOES_NOT_EXIST":                                      Financial_Quotes_FinraBBODoesNotExist,
+	"FINRA BBO: Does not Exist":                                     Financial_Quotes_FinraBBODoesNotExist,
+	"FINRA_BB_BO_EXECUTABLE":                                        Financial_Quotes_FinraBBBOExecutable,
+	"FINRA BB / BO: Executable":                                     Financial_Quotes_FinraBBBOExecutable,
+	"FINRA_BB_BELOW_LOWER_BAND":                                     Financial_Quotes_FinraBBBelowLowerBand,
+	"FINRA BB: Below Lower Band":                                    Financial_Quotes_FinraBBBelowLowerBand,
+	"FINRA_BO_ABOVE_UPPER_BAND":                                     Financial_Quotes_FinraBOAboveUpperBand,
+	"FINRA BO: Above Upper Band":                                    Financial_Quotes_FinraBOAboveUpperBand,
+	"FINRA_BB_BELOW_LOWER_BAND_BO_ABOVE_UPPER_BAND":                 Financial_Quotes_FinraBBBelowLowerBandBOAbboveUpperBand,
+	"FINRA: BB Below Lower Band and BO Above Upper Band":            Financial_Quotes_FinraBBBelowLowerBandBOAbboveUpperBand,
+	"CTA_NOT_DUE_TO_RELATED_SECURITY":                               Financial_Quotes_CTANotDueToRelatedSecurity,
+	"CTA: Not Due to Related Security":                              Financial_Quotes_CTANotDueToRelatedSecurity,
+	"CTA_DUE_TO_RELATED_SECURITY":                                   Financial_Quotes_CTADueToRelatedSecurity,
+	"CTA: Due to Related Security":                                  Financial_Quotes_CTADueToRelatedSecurity,
+	"CTA_NOT_IN_VIEW_OF_COMMON":                                     Financial_Quotes_CTANotInViewOfCommon,
+	"CTA: Not in View of Common":                                    Financial_Quotes_CTANotInViewOfCommon,
+	"CTA_IN_VIEW_OF_COMMON":                                         Financial_Quotes_CTAInViewOfCommon,
+	"CTA: In View of Common":                                        Financial_Quotes_CTAInViewOfCommon,
+	"CTA_PRICE_INDICATOR":                                           Financial_Quotes_CTAPriceIndicator,
+	"CTA: Price Indicator":                                          Financial_Quotes_CTAPriceIndicator,
+	"CTA_NEW_PRICE_INDICATOR":                                       Financial_Quotes_CTANewPriceIndicator,
+	"CTA: New Price Indicator":                                      Financial_Quotes_CTANewPriceIndicator,
+	"CTA_CORRECTED_PRICE_INDICATION":                                Financial_Quotes_CTACorrectedPriceIndication,
+	"CTA: Corrected Price Indicator":                                Financial_Quotes_CTACorrectedPriceIndication,
+	"CTA_CANCELLED_MARKET_IMBALANCE_PRICE_TRADING_RANGE_INDICATION": Financial_Quotes_CTACancelledMarketImbalance,
+	"CTA: Cancelled Market Imbalance":                               Financial_Quotes_CTACancelledMarketImbalance,
+	"CTA:LULD:A":                                                    Financial_Quotes_NBBNBOExecutable,
+	"CTA:LULD:B":                                                    Financial_Quotes_NBBBelowLowerBand,
+	"CTA:LULD:C":                                                    Financial_Quotes_NBOAboveUpperBand,
+	"CTA:LULD:D":                                                    Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand,
+	"CTA:LULD:E":                                                    Financial_Quotes_NBBEqualsUpperBand,
+	"CTA:LULD:F":                                                    Financial_Quotes_NBOEqualsLowerBand,
+	"CTA:LULD:G":                                                    Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand,
+	"CTA:LULD:H":                                                    Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand,
+	"UTP:LULD:A":                                                    Financial_Quotes_NBBNBOExecutable,
+	"UTP:LULD:B":                                                    Financial_Quotes_NBBBelowLowerBand,
+	"UTP:LULD:C":                                                    Financial_Quotes_NBOAboveUpperBand,
+	"UTP:LULD:D":                                                    Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand,
+	"UTP:LULD:E":                                                    Financial_Quotes_NBBEqualsUpperBand,
+	"UTP:LULD:F":                                                    Financial_Quotes_NBOEqualsLowerBand,
+	"UTP:LULD:G":                                                    Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand,
+	"UTP:LULD:H":                                                    Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand,
+	"UTP:NBBO:0":                                                    Financial_Quotes_NBBONoChange,
+	"UTP:NBBO:1":                                                    Financial_Quotes_NBBONoBBNoBO,
+	"UTP:NBBO:2":                                                    Financial_Quotes_NBBOBBBOShortAppendage,
+	"UTP:NBBO:3":                                                    Financial_Quotes_NBBOBBBOLongAppendage,
+	"UTP:NBBO:4":                                                    Financial_Quotes_NBBOQuoteIsNBBO,
+	"CTA:SSR:A":                                                     Financial_Quotes_ShortSalesRestrictionActivated,
+	"CTA:SSR:C":                                                     Financial_Quotes_ShortSalesRestrictionContinued,
+	"CTA:SSR:D":                                                     Financial_Quotes_ShortSalesRestrictionDeactivated,
+	"CTA:SSR:E":                                                     Financial_Quotes_ShortSalesRestrictionInEffect,
+}
+
+// QuoteIndicatorAlternates contains alternative values for the Financial.Quotes.Indicator enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var QuoteIndicatorAlternates = copyTable(quoteIndicatorAlternates)
+
+// quoteIndicatorMapping contains alternate names for the Financial.Quotes.Indicator enum
+var quoteIndicatorMapping = map[Financial_Quotes_Indicator]string{
+	Financial_Quotes_NBBNBOExecutable:                            "NBB and/or NBO are Executable",
+	Financial_Quotes_NBBBelowLowerBand:                           "NBB below Lower Band",
+	Financial_Quotes_NBOAboveUpperBand:                           "NBO above Upper Band",
+	Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand:       "NBB below Lower Band and NBO above Upper Band",
+	Financial_Quotes_NBBEqualsUpperBand:                          "NBB equals Upper Band",
+	Financial_Quotes_NBOEqualsLowerBand:                          "NBO equals Lower Band",
+	Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand:      "NBB equals Upper Band and NBO above Upper Band",
+	Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand:      "NBB below Lower Band and NBO equals Lower Band",
+	Financial_Quotes_BidPriceAboveUpperLimitPriceBand:            "Bid Price above Upper Limit Price Band",
+	Financial_Quotes_OfferPriceBelowLowerLimitPriceBand:          "Offer Price below Lower Limit Price Band",
+	Financial_Quotes_BidAndOfferOutsidePriceBand:                 "Bid and Offer outside Price Band",
+	Financial_Quotes_OpeningUpdate:                               "Opening Update",
+	Financial_Quotes_IntraDayUpdate:                              "Intra-Day Update",
+	Financial_Quotes_RestatedValue:                               "Restated Value",
+	Financial_Quotes_SuspendedDuringTradingHalt:                  "Suspended during Trading Halt or Trading Pause",
+	Financial_Quotes_ReOpeningUpdate:                             "Re-Opening Update",
+	Financial_Quotes_OutsidePriceBandRuleHours:                   "Outside Price Band Rule Hours",
+	Financial_Quotes_AuctionExtension:                            "Auction Extension (Auction Collar Message)",
+	Financial_Quotes_LULDPriceBandInd:                            "LULD Price Band",
+	Financial_Quotes_RepublishedLULDPriceBandInd:                 "Republished LULD Price Band",
+	Financial_Quotes_NBBLimitStateEntered:                        "NBB Limit State Entered",
+	Financial_Quotes_NBBLimitStateExited:                         "NBB Limit State Exited",
+	Financial_Quotes_NBOLimitStateEntered:                        "NBO Limit State Entered",
+	Financial_Quotes_NBOLimitStateExited:                         "NBO Limit State Exited",
+	Financial_Quotes_NBBAndNBOLimitStateEntered:                  "NBB and NBO Limit State Entered",
+	Financial_Quotes_NBBAndNBOLimitStateExited:                   "NBB and NBO Limit State Exited",
+	Financial_Quotes_NBBLimitStateEnteredNBOLimitStateExited:     "NBB Limit State Entered and NBO Limit State Exited",
+	Financial_Quotes_NBBLimitStateExitedNBOLimitStateEntered:     "NBB Limit State Exited and NBO Limit State Entered",
+	Financial_Quotes_Deficient:                                   "Deficient - Below Listing Requirements",
+	Financial_Quotes_Delinquent:                                  "Delinquent - Late Filing",
+	Financial_Quotes_BankruptAndDeficient:                        "Bankrupt and Deficient",
+	Financial_Quotes_BankruptAndDelinquent:                       "Bankrupt and Delinquent",
+	Financial_Quotes_DeficientAndDelinquent:                      "Deficient and Delinquent",
+	Financial_Quotes_DeficientDeliquentBankrupt:                  "Deficient, Delinquent, and Bankrupt",
+	Financial_Quotes_CreationsSuspended:                          "Creations Suspended",
+	Financial_Quotes_RedemptionsSuspended:                        "Redemptions Suspended",
+	Financial_Quotes_CreationsRedemptionsSuspended:               "Creations and/or Redemptions Suspended",
+	Financial_Quotes_NormalTrading:                               "Normal Trading",
+	Financial_Quotes_OpeningDelay:                                "Opening Delay",
+	Financial_Quotes_TradingHalt:                                 "Trading Halt",
+	Financial_Quotes_TradingResume:                               "Resume",
+	Financial_Quotes_NoOpenNoResume:                              "No Open / No Resume",
+	Financial_Quotes_PriceIndication:                             "Price Indication",
+	Financial_Quotes_TradingRangeIndication:                      "Trading Range Indication",
+	Financial_Quotes_MarketImbalanceBuy:                          "Market Imbalance Buy",
+	Financial_Quotes_MarketImbalanceSell:                         "Market Imbalance Sell",
+	Financial_Quotes_MarketOnCloseImbalanceBuy:                   "Market On-Close Imbalance Buy",
+	Financial_Quotes_MarketOnCloseImbalanceSell:                  "Market On Close Imbalance Sell",
+	Financial_Quotes_NoMarketImbalance:                           "No Market Imbalance",
+	Financial_Quotes_NoMarketOnCloseImbalance:                    "No Market, On-Close Imbalance",
+	Financial_Quotes_ShortSaleRestriction:                        "Short Sale Restriction",
+	Financial_Quotes_LimitUpLimitDown:                            "Limit Up-Limit Down",
+	Financial_Quotes_QuotationResumption:                         "Quotation Resumption",
+	Financial_Quotes_TradingResumption:                           "Trading Resumption",
+	Financial_Quotes_VolatilityTradingPause:                      "Volatility Trading Pause",
+	Financial_Quotes_HaltNewsPending:                             "Halt: News Pending",
+	Financial_Quotes_UpdateNewsDissemination:                     "Update: News Dissemination",
+	Financial_Quotes_HaltSingleStockTradingPause:                 "Halt: Single Stock Trading Pause in Affect",
+	Financial_Quotes_HaltRegulatoryExtraordinaryMarketActivity:   "Halt: Regulatory Extraordinary Market Activity",
+	Financial_Quotes_HaltETF:                                     "Halt: ETF",
+	Financial_Quotes_HaltInformationRequested:                    "Halt: Information Requested",
+	Financial_Quotes_HaltExchangeNonCompliance:                   "Halt: Exchange Non-Compliance",
+	Financial_Quotes_HaltFilingsNotCurrent:                       "Halt: Filings Not Current",
+	Financial_Quotes_HaltSECTradingSuspension:                    "Halt: SEC Trading Suspension",
+	Financial_Quotes_HaltRegulatoryConcern:                       "Halt: Regulatory Concern",
+	Financial_Quotes_HaltMarketOperations:                        "Halt: Market Operations",
+	Financial_Quotes_IPOSecurityNotYetTrading:                    "IPO Security: Not Yet Trading",
+	Financial_Quotes_HaltCorporateAction:                         "Halt: Corporate Action",
+	Financial_Quotes_QuotationNotAvailable:                       "Quotation Not Available",
+	Financial_Quotes_HaltVolatilityTradingPause:                  "Halt: Volatility Trading Pause",
+	Financial_Quotes_HaltVolatilityTradingPauseStraddleCondition: "Halt: Volatility Trading Pause - Straddle Condition",
+	Financial_Quotes_UpdateNewsAndResumptionTimes:                "Update: News and Resumption Times",
+	Financial_Quotes_HaltSingleStockTradingPauseQuotesOnly:       "Halt: Single Stock Trading Pause - Quotes Only",
+	Financial_Quotes_ResumeQualificationIssuesReviewedResolved:   "Resume: Qualification Issues Reviewed / Resolved",
+	Financial_Quotes_ResumeFilingRequirementsSatisfiedResolved:   "Resume: Filing Requirements Satisfied / Resolved",
+	Financial_Quotes_ResumeNewsNotForthcoming:                    "Resume: News Not Forthcoming",
+	Financial_Quotes_ResumeQualificationsMaintRequirementsMet:    "Resume: Qualifications - Maintenance Requirements Met",
+	Financial_Quotes_ResumeQualificationsFilingsMet:              "Resume: Qualifications - Filings Met",
+	Financial_Quotes_ResumeRegulatoryAuth:                        "Resume: Regulatory Auth",
+	Financial_Quotes_NewIssueAvailable:                           "New Issue Available",
+	Financial_Quotes_IssueAvailable:                              "Issue Available",
+	Financial_Quotes_MWCBCarryFromPreviousDay:                    "MWCB - Carry from Previous Day",
+	Financial_Quotes_MWCBResume:                                  "MWCB - Resume",
+	Financial_Quotes_IPOSecurityReleasedForQuotation:             "IPO Security: Released for Quotation",
+	Financial_Quotes_IPOSecurityPositioningWindowExtension:       "IPO Security: Positioning Window Extension",
+	Financial_Quotes_MWCBLevel1:                                  "MWCB - Level 1",
+	Financial_Quotes_MWCBLevel2:                                  "MWCB - Level 2",
+	Financial_Quotes_MWCBLevel3:                                  "MWCB - Level 3",
+	Financial_Quotes_HaltSubPennyTrading:                         "Halt: Sub-Penny Trading",
+	Financial_Quotes_OrderImbalanceInd:                           "Order Imbalance",
+	Financial_Quotes_LULDTradingPaused:                           "LULD Trading Paused",
+	Financial_Quotes_NONE:                                        "Security Status: None",
+	Financial_Quotes_ShortSalesRestrictionActivated:              "Short Sales Restriction Activated",
+	Financial_Quotes_ShortSalesRestrictionContinued:              "Short Sales Restriction Continued",
+	Financial_Quotes_ShortSalesRestrictionDeactivated:            "Short Sales Restriction Deactivated",
+	Financial_Quotes_ShortSalesRestrictionInEffect:               "Short Sales Restriction in Effect",
+	Financial_Quotes_ShortSalesRestrictionMax:                    "Short Sales Restriction Max",
+	Financial_Quotes_NBBONoChange:                                "NBBO: No Change",
+	Financial_Quotes_NBBOQuoteIsNBBO:                             "NBBO: Quote is NBBO",
+	Financial_Quotes_NBBONoBBNoBO:                                "NBBO: No BB, No BO",
+	Financial_Quotes_NBBOBBBOShortAppendage:                      "NBBO: BB / BO Short Appendage",
+	Financial_Quotes_NBBOBBBOLongAppendage:                       "NBBO: BB / BO Long Appendage",
+	Financial_Quotes_HeldTradeNotLastSaleNotConsolidated:         "Held Trade not Last Sale, not Consolidated",
+	Financial_Quotes_HeldTradeLastSaleButNotConsolidated:         "Held Trade Last Sale but not Consolidated",
+	Financial_Quotes_HeldTradeLastSaleAndConsolidated:            "Held Trade Last Sale and Consolidated",
+	Financial_Quotes_RetailInterestOnBid:                         "Retail Interest on Bid",
+	Financial_Quotes_RetailInterestOnAsk:                         "Retail Interest on Ask",
+	Financial_Quotes_RetailInterestOnBidAndAsk:                   "Retail Interest on Bid and Ask",
+	Financial_Quotes_FinraBBONoChange:                            "FINRA BBO: No Change",
+	Financial_Quotes_FinraBBODoesNotExist:                        "FINRA BBO: Does not Exist",
+	Financial_Quotes_FinraBBBOExecutable:                         "FINRA BB / BO: Executable",
+	Financial_Quotes_FinraBBBelowLowerBand:                       "FINRA BB: Below Lower Band",
+	Financial_Quotes_FinraBOAboveUpperBand:                       "FINRA BO: Above Upper Band",
+	Financial_Quotes_FinraBBBelowLowerBandBOAbboveUpperBand:      "FINRA: BB Below Lower Band and BO Above Upper Band",
+	Financial_Quotes_CTANotDueToRelatedSecurity:                  "CTA: Not Due to Related Security",
+	Financial_Quotes_CTADueToRelatedSecurity:                     "CTA: Due to Related Security",
+	Financial_Quotes_CTANotInViewOfCommon:                        "CTA: Not in View of Common",
+	Financial_Quotes_CTAInViewOfCommon:                           "CTA: In View of Common",
+	Financial_Quotes_CTAPriceIndicator:                           "CTA: Price Indicator",
+	Financial_Quotes_CTANewPriceIndicator:                        "CTA: New Price Indicator",
+	Financial_Quotes_CTACorrectedPriceIndication:                 "CTA: Corrected Price Indicator",
+	Financial_Quotes_CTACancelledMarketImbalance:                 "CTA: Cancelled Market Imbalance",
+}
+
+// QuoteIndicatorMapping contains alternate names for the Financial.Quotes.Indicator enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var QuoteIndicatorMapping = copyTable(quoteIndicatorMapping)
+
+// quoteIndicatorCodes contains the codes used for the values of the Financial.Quotes.Indicator enum by each of the sources
+// that disseminate them. Each code is also included in the alternates, prefixed by the name of its source
+var quoteIndicatorCodes = map[string]map[string]Financial_Quotes_Indicator{
+	"CTA:LULD": {
+		"A": Financial_Quotes_NBBNBOExecutable,
+		"B": Financial_Quotes_NBBBelowLowerBand,
+		"C": Financial_Quotes_NBOAboveUpperBand,
+		"D": Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand,
+		"E": Financial_Quotes_NBBEqualsUpperBand,
+		"F": Financial_Quotes_NBOEqualsLowerBand,
+		"G": Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand,
+		"H": Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand,
+	},
+	"UTP:LULD": {
+		"A": Financial_Quotes_NBBNBOExecutable,
+		"B": Financial_Quotes_NBBBelowLowerBand,
+		"C": Financial_Quotes_NBOAboveUpperBand,
+		"D": Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand,
+		"E": Financial_Quotes_NBBEqualsUpperBand,
+		"F": Financial_Quotes_NBOEqualsLowerBand,
+		"G": Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand,
+		"H": Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand,
+	},
+	"UTP:NBBO": {
+		"0": Financial_Quotes_NBBONoChange,
+		"1": Financial_Quotes_NBBONoBBNoBO,
+		"2": Financial_Quotes_NBBOBBBOShortAppendage,
+		"3": Financial_Quotes_NBBOBBBOLongAppendage,
+		"4": Financial_Quotes_NBBOQuoteIsNBBO,
+	},
+	"CTA:SSR": {
+		"A": Financial_Quotes_ShortSalesRestrictionActivated,
+		"C": Financial_Quotes_ShortSalesRestrictionContinued,
+		"D": Financial_Quotes_ShortSalesRestrictionDeactivated,
+		"E": Financial_Quotes_ShortSalesRestrictionInEffect,
+	},
+}
+
+// QuoteIndicatorDescriptions contains the descriptions of the values of the Financial.Quotes.Indicator enum
+var QuoteIndicatorDescriptions = map[Financial_Quotes_Indicator]string{
+	Financial_Quotes_NBBNBOExecutable:                            "NBB and/or NBO are Executable",
+	Financial_Quotes_NBBBelowLowerBand:                           "NBB below Lower Band",
+	Financial_Quotes_NBOAboveUpperBand:                           "NBO above Upper Band",
+	Financial_Quotes_NBBBelowLowerBandAndNBOAboveUpperBand:       "NBB below Lower Band and NBO above Upper Band",
+	Financial_Quotes_NBBEqualsUpperBand:                          "NBB equals Upper Band",
+	Financial_Quotes_NBOEqualsLowerBand:                          "NBO equals Lower Band",
+	Financial_Quotes_NBBEqualsUpperBandAndNBOAboveUpperBand:      "NBB equals Upper Band and NBO above Upper Band",
+	Financial_Quotes_NBBBelowLowerBandAndNBOEqualsLowerBand:      "NBB below Lower Band and NBO equals Lower Band",
+	Financial_Quotes_BidPriceAboveUpperLimitPriceBand:            "Bid Price above Upper Limit Price Band",
+	Financial_Quotes_OfferPriceBelowLowerLimitPriceBand:          "Offer Price below Lower Limit Price Band",
+	Financial_Quotes_BidAndOfferOutsidePriceBand:                 "Bid and Offer outside Price Band",
+	Financial_Quotes_OpeningUpdate:                               "Opening Update",
+	Financial_Quotes_IntraDayUpdate:                              "Intra-Day Update",
+	Financial_Quotes_RestatedValue:                               "Restated Value",
+	Financial_Quotes_SuspendedDuringTradingHalt:                  "Suspended during Trading Halt or Trading Pause",
+	Financial_Quotes_ReOpeningUpdate:                             "Re-Opening Update",
+	Financial_Quotes_OutsidePriceBandRuleHours:                   "Outside Price Band rule hours",
+	Financial_Quotes_AuctionExtension:                            "Auction Extension (Auction Collar message)",
+	Financial_Quotes_LULDPriceBandInd:                            "LULD Price Band",
+	Financial_Quotes_RepublishedLULDPriceBandInd:                 "Republished LULD Price Band",
+	Financial_Quotes_NBBLimitStateEntered:                        "NBB Limit State entered",
+	Financial_Quotes_NBBLimitStateExited:                         "NBB Limit State exited",
+	Financial_Quotes_NBOLimitStateEntered:                        "NBO Limit State entered",
+	Financial_Quotes_NBOLimitStateExited:                         "NBO Limit State exited",
+	Financial_Quotes_NBBAndNBOLimitStateEntered:                  "NBB and NBO Limit State entered",
+	Financial_Quotes_NBBAndNBOLimitStateExited:                   "NBB and NBO Limit State exited",
+	Financial_Quotes_NBBLimitStateEnteredNBOLimitStateExited:     "NBB Limit State entered and NBO Limit State exited",
+	Financial_Quotes_NBBLimitStateExitedNBOLimitStateEntered:     "NBB Limit State exited AND NBO Limit State entered",
+	Financial_Quotes_Normal:                                      "Normal",
+	Financial_Quotes_Bankrupt:                                    "Bankrupt",
+	Financial_Quotes_Deficient:                                   "Deficient - Below listing requirements",
+	Financial_Quotes_Delinquent:                                  "Delinquent - Late filing",
+	Financial_Quotes_BankruptAndDeficient:                        "Bankrupt and Deficient",
+	Financial_Quotes_BankruptAndDelinquent:                       "Bankrupt and Delinquent",
+	Financial_Quotes_DeficientAndDelinquent:                      "Deficient and Delinquent",
+	Financial_Quotes_DeficientDeliquentBankrupt:                  "Deficient, Delinquent and Bankrupt",
+	Financial_Quotes_Liquidation:                                 "Liquidation",
+	Financial_Quotes_CreationsSuspended:                          "Creations Suspended",
+	Financial_Quotes_RedemptionsSuspended:                        "Redemptions Suspended",
+	Financial_Quotes_CreationsRedemptionsSuspended:               "Creations and/or Redemptions Suspended",
+	Financial_Quotes_NormalTrading:                               "Normal Trading",
+	Financial_Quotes_OpeningDelay:                                "Opening Delay",
+	Financial_Quotes_TradingHalt:                                 "Trading Halt",
+	Financial_Quotes_TradingResume:                               "Resume",
+	Financial_Quotes_NoOpenNoResume:                              "No Open / No Resume",
+	Financial_Quotes_PriceIndication:                             "Price Indication",
+	Financial_Quotes_TradingRangeIndication:                      "Trading Range Indication",
+	Financial_Quotes_MarketImbalanceBuy:                          "Market Imbalance Buy",
+	Financial_Quotes_MarketImbalanceSell:                         "Market Imbalance Sell",
+	Financial_Quotes_MarketOnCloseImbalanceBuy:                   "Market On-Close Imbalance Buy",
+	Financial_Quotes_MarketOnCloseImbalanceSell:                  "Market On-Close Imbalance Sell",
+	Financial_Quotes_NoMarketImbalance:                           "No Market Imbalance",
+	Financial_Quotes_NoMarketOnCloseImbalance:                    "No Market On-Close Imbalance",
+	Financial_Quotes_ShortSaleRestriction:                        "Short Sale Restriction",
+	Financial_Quotes_LimitUpLimitDown:                            "Limit Up / Limit Down",
+	Financial_Quotes_QuotationResumption:                         "Quotation Resumption",
+	Financial_Quotes_TradingResumption:                           "Trading Resumption",
+	Financial_Quotes_VolatilityTradingPause:                      "Volatility Trading Pause",
+	Financial_Quotes_Reserved:                                    "RESERVED",
+	Financial_Quotes_HaltNewsPending:                             "Halt: News Pending",
+	Financial_Quotes_UpdateNewsDissemination:                     "Update: News Dissemination",
+	Financial_Quotes_HaltSingleStockTradingPause:                 "Halt: Single Stock Trading Pause in affect",
+	Financial_Quotes_HaltRegulatoryExtraordinaryMarketActivity:   "Half: Regulatory Extraordinary Market Activity",
+	Financial_Quotes_HaltETF:                                     "Halt: ETF",
+	Financial_Quotes_HaltInformationRequested:                    "Halt: Information Requested",
+	Financial_Quotes_HaltExchangeNonCompliance:                   "Halt: Exchange Non-Compliance",
+	Financial_Quotes_HaltFilingsNotCurrent:                       "Halt: Filings not current",
+	Financial_Quotes_HaltSECTradingSuspension:                    "Halt: SEC Trading Suspension",
+	Financial_Quotes_HaltRegulatoryConcern:                       "Halt: Regulatory Concern",
+	Financial_Quotes_HaltMarketOperations:                        "Halt: Market Operations",
+	Financial_Quotes_IPOSecurityNotYetTrading:                    "IPO Security: Not yet Trading",
+	Financial_Quotes_HaltCorporateAction:                         "Halt: Corporate Action",
+	Financial_Quotes_QuotationNotAvailable:                       "Quotation Not Available",
+	Financial_Quotes_HaltVolatilityTradingPause:                  "Halt: Volatility Trading Pause",
+	Financial_Quotes_HaltVolatilityTradingPauseStraddleCondition: "Halt: Volatility Trading Pause - Straddle Condition",
+	Financial_Quotes_UpdateNewsAndResumptionTimes:                "Update: News and Resumption Times",
+	Financial_Quotes_HaltSingleStockTradingPauseQuotesOnly:       "Halt: Single Stock Trading Pause - Quotes Only",
+	Financial_Quotes_ResumeQualificationIssuesReviewedResolved:   "Resume: Qualification Issues Reviewed / Resolved",
+	Financial_Quotes_ResumeFilingRequirementsSatisfiedResolved:   "Resume: Filing Requirements Satisfied / Resolved",
+	Financial_Quotes_ResumeNewsNotForthcoming:                    "Resume: News not Forthcoming",
+	Financial_Quotes_ResumeQualificationsMaintRequirementsMet:    "Resume: Qualifications - Maintenance Requirements Met",
+	Financial_Quotes_ResumeQualificationsFilingsMet:              "Resume: Qualifications - Filings Met",
+	Financial_Quotes_ResumeRegulatoryAuth:                        "Resume: Regulatory Auth",
+	Financial_Quotes_NewIssueAvailable:                           "New Issue Available",
+	Financial_Quotes_IssueAvailable:                              "Issue Available",
+	Financial_Quotes_MWCBCarryFromPreviousDay:                    "MWCB - Carry from previous day",
+	Financial_Quotes_MWCBResume:                                  "MWCB - Resume",
+	Financial_Quotes_IPOSecurityReleasedForQuotation:             "IPO Security: Released for quotation",
+	Financial_Quotes_IPOSecurityPositioningWindowExtension:       "IPO Security: Positioning window extension",
+	Financial_Quotes_MWCBLevel1:                                  "MWCB - Level 1",
+	Financial_Quotes_MWCBLevel2:                                  "MWCB - Level 2",
+	Financial_Quotes_MWCBLevel3:                                  "MWCB - Level 3",
+	Financial_Quotes_HaltSubPennyTrading:                         "Halt: Sub-Penny Trading",
+	Financial_Quotes_OrderImbalanceInd:                           "Order Imbalance",
+	Financial_Quotes_LULDTradingPaused:                           "LULD Trading Pause",
+	Financial_Quotes_NONE:                                        "None",
+	Financial_Quotes_ShortSalesRestrictionActivated:              "Short Sales Restriction Activated",
+	Financial_Quotes_ShortSalesRestrictionContinued:              "Short Sales Restriction Continued",
+	Financial_Quotes_ShortSalesRestrictionDeactivated:            "Short Sales Restriction Deactivated",
+	Financial_Quotes_ShortSalesRestrictionInEffect:               "Short Sales Restriction in Effect",
+	Financial_Quotes_ShortSalesRestrictionMax:                    "Short Sales Restriction Max",
+	Financial_Quotes_NBBONoChange:                                "NBBO_NO_CHANGE",
+	Financial_Quotes_NBBOQuoteIsNBBO:                             "NBBO_QUOTE_IS_NBBO",
+	Financial_Quotes_NBBONoBBNoBO:                                "NBBO_NO_BB_NO_BO",
+	Financial_Quotes_NBBOBBBOShortAppendage:                      "NBBO_BB_BO_SHORT_APPENDAGE",
+	Financial_Quotes_NBBOBBBOLongAppendage:                       "NBBO_BB_BO_LONG_APPENDAGE",
+	Financial_Quotes_HeldTradeNotLastSaleNotConsolidated:         "HELD_TRADE_NOT_LAST_SALE_AND_NOT_ON_CONSOLIDATED",
+	Financial_Quotes_HeldTradeLastSaleButNotConsolidated:         "HELD_TRADE_LAST_SALE_BUT_NOT_ON_CONSOLIDATED",
+	Financial_Quotes_HeldTradeLastSaleAndConsolidated:            "HELD_TRADE_LAST_SALE_AND_ON_CONSOLIDATED",
+	Financial_Quotes_RetailInterestOnBid:                         "RETAIL_INTEREST_ON_BID",
+	Financial_Quotes_RetailInterestOnAsk:                         "RETAIL_INTEREST_ON_ASK",
+	Financial_Quotes_RetailInterestOnBidAndAsk:                   "RETAIL_INTEREST_ON_BID_AND_ASK",
+	Financial_Quotes_FinraBBONoChange:                            "FINRA_BBO_NO_CHANGE",
+	Financial_Quotes_FinraBBODoesNotExist:                        "FINRA_BBO_DOES_NOT_EXIST",
+	Financial_Quotes_FinraBBBOExecutable:                         "FINRA_BB_BO_EXECUTABLE",
+	Financial_Quotes_FinraBBBelowLowerBand:                       "FINRA_BB_BELOW_LOWER_BAND",
+	Financial_Quotes_FinraBOAboveUpperBand:                       "FINRA_BO_ABOVE_UPPER_BAND",
+	Financial_Quotes_FinraBBBelowLowerBandBOAbboveUpperBand:      "FINRA_BB_BELOW_LOWER_BAND_BO_ABOVE_UPPER_BAND",
+	Financial_Quotes_CTANotDueToRelatedSecurity:                  "CTA_NOT_DUE_TO_RELATED_SECURITY",
+	Financial_Quotes_CTADueToRelatedSecurity:                     "CTA_DUE_TO_RELATED_SECURITY",
+	Financial_Quotes_CTANotInViewOfCommon:                        "CTA_NOT_IN_VIEW_OF_COMMON",
+	Financial_Quotes_CTAInViewOfCommon:                           "CTA_IN_VIEW_OF_COMMON",
+	Financial_Quotes_CTAPriceIndicator:                           "CTA_PRICE_INDICATOR",
+	Financial_Quotes_CTANewPriceIndicator:                        "CTA_NEW_PRICE_INDICATOR",
+	Financial_Quotes_CTACorrectedPriceIndication:                 "CTA_CORRECTED_PRICE_INDICATION",
+	Financial_Quotes_CTACancelledMarketImbalance:                 "CTA_CANCELLED_MARKET_IMBALANCE_PRICE_TRADING_RANGE_INDICATION",
+}
+
+// tradeConditionAlternates contains alternative values for the Financial.Trades.Condition enum
+var tradeConditionAlternates = map[string]Financial_Trades_Condition{
+	"CANC":                   Financial_Trades_Canceled,
+	"OSEQ":                   Financial_Trades_LateAndOutOfSequence,
+	"CNCL":                   Financial_Trades_LastAndCanceled,
+	"LATE":                   Financial_Trades_Late,
+	"CNCO":                   Financial_Trades_OpeningTradeAndCanceled,
+	"OPEN":                   Financial_Trades_OpeningTradeLateAndOutOfSequence,
+	"CNOL":                   Financial_Trades_OnlyTradeAndCanceled,
+	"OPNL":                   Financial_Trades_OpeningTradeAndLate,
+	"AUTO":                   Financial_Trades_AutomaticExecutionOption,
+	"REOP":                   Financial_Trades_ReopeningTrade,
+	"ISOI":                   Financial_Trades_IntermarketSweepOrder,
+	"SLAN":                   Financial_Trades_SingleLegAuctionNonISO,
+	"SLAI":                   Financial_Trades_SingleLegAuctionISO,
+	"SLCN":                   Financial_Trades_SingleLegCrossNonISO,
+	"SLCI":                   Financial_Trades_SingleLegCrossISO,
+	"SLFT":                   Financial_Trades_SingleLegFloorTrade,
+	"MLET":                   Financial_Trades_MultiLegAutoElectronicTrade,
+	"MLAT":                   Financial_Trades_MultiLegAuction,
+	"MLCT":                   Financial_Trades_MultiLegCross,
+	"MLFT":                   Financial_Trades_MultiLegFloorTrade,
+	"MESL":                   Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg,
+	"TLAT":                   Financial_Trades_StockOptionsAuction,
+	"MASL":                   Financial_Trades_MultiLegAuctionAgainstSingleLeg,
+	"MFSL":                   Financial_Trades_MultiLegFloorTradeAgainstSingleLeg,
+	"TLET":                   Financial_Trades_StockOptionsAutoElectronicTrade,
+	"TLCT":                   Financial_Trades_StockOptionsCross,
+	"TLFT":                   Financial_Trades_StockOptionsFloorTrade,
+	"TESL":                   Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg,
+	"TASL":                   Financial_Trades_StockOptionsAuctionAgainstSingleLeg,
+	"TFSL":                   Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg,
+	"CBMO":                   Financial_Trades_MultiLegFloorTradeOfProprietaryProducts,
+	"MCTP":                   Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts,
+	"EXHT":                   Financial_Trades_ExtendedHoursTrade,
+	"Regular Sale":           Financial_Trades_RegularSale,
+	"Average Price Trade":    Financial_Trades_AveragePriceTrade,
+	"Automatic Execution":    Financial_Trades_AutomaticExecution,
+	"Bunched Trade":          Financial_Trades_BunchedTrade,
+	"Bunched Sold Trade":     Financial_Trades_BunchedSoldTrade,
+	"CAP Election":           Financial_Trades_CAPElection,
+	"Cash Sale":              Financial_Trades_CashSale,
+	"Closing Prints":         Financial_Trades_ClosingPrints,
+	"Cross Trade":            Financial_Trades_CrossTrade,
+	"Derivatively Priced":    Financial_Trades_DerivativelyPriced,
+	"Form T":                 Financial_Trades_FormT,
+	"Extended Trading Hours": Financial_Trades_ExtendedTradingHours,
+	"Sold Out of Sequence":   Financial_Trades_ExtendedTradingHours,
+	"Extended Trading Hours (Sold Out of Sequence)":           Financial_Trades_ExtendedTradingHours,
+	"Intermarket Sweep":                                       Financial_Trades_IntermarketSweep,
+	"Market Center Official Close":                            Financial_Trades_MarketCenterOfficialClose,
+	"Market Center Official Open":                             Financial_Trades_MarketCenterOfficialOpen,
+	"Market Center Opening Trade":                             Financial_Trades_MarketCenterOpeningTrade,
+	"Market Center Reopening Trade":                           Financial_Trades_MarketCenterReopeningTrade,
+	"Market Center Closing Trade":                             Financial_Trades_MarketCenterClosingTrade,
+	"Next Day":                                                Financial_Trades_NextDay,
+	"Price Variation Trade":                                   Financial_Trades_PriceVariationTrade,
+	"Prior Reference Price":                                   Financial_Trades_PriorReferencePrice,
+	"Rule 155 Trade (AMEX)":                                   Financial_Trades_Rule155Trade,
+	"Rule 127 NYSE":                                           Financial_Trades_Rule127NYSE,
+	"Opening Prints":                                          Financial_Trades_OpeningPrints,
+	"Stopped Stock (Regular Trade)":                           Financial_Trades_StoppedStock,
+	"Re-Opening Prints":                                       Financial_Trades_ReOpeningPrints,
+	"Sold Last":                                               Financial_Trades_SoldLast,
+	"Sold Last and Stopped Stock":                             Financial_Trades_SoldLastAndStoppedStock,
+	"Sold Out":                                                Financial_Trades_SoldOut,
+	"Sold (Out of Sequence)":                                  Financial_Trades_SoldOutOfSequence,
+	"Split Trade":                                             Financial_Trades_SplitTrade,
+	"Stock Option":                                            Financial_Trades_StockOption,
+	"Yellow Flag Regular Trade":                               Financial_Trades_YellowFlagRegularTrade,
+	"Odd Lot Trade":                                           Financial_Trades_OddLotTrade,
+	"Corrected Consolidated Close":                            Financial_Trades_CorrectedConsolidatedClose,
+	"Trade Thru Exempt":                                       Financial_Trades_TradeThruExempt,
+	"Non-Eligible":                                            Financial_Trades_NonEligible,
+	"Non-Eligible Extended":                                   Financial_Trades_NonEligibleExtended,
+	"As of":                                                   Financial_Trades_AsOf,
+	"As of Correction":                                        Financial_Trades_AsOfCorrection,
+	"As of Cancel":                                            Financial_Trades_AsOfCancel,
+	"Contingent Trade":                                        Financial_Trades_ContingentTrade,
+	"Qualified Contingent Trade (QCT)":                        Financial_Trades_QualifiedContingentTrade,
+	"OPENING_REOPENING_TRADE_DETAIL":                          Financial_Trades_OpeningReopeningTradeDetail,
+	"Opening / Reopening Trade Detail":                        Financial_Trades_OpeningReopeningTradeDetail,
+	"Short Sale Restriction Activated":                        Financial_Trades_ShortSaleRestrictionActivated,
+	"Short Sale Restriction Continued":                        Financial_Trades_ShortSaleRestrictionContinued,
+	"Short Sale Restriction Deactivated":                      Financial_Trades_ShortSaleRestrictionDeactivated,
+	"Short Sale Restriction in Effect":                        Financial_Trades_ShortSaleRestrictionInEffect,
+	"Financial Status: Bankrupt":                              Financial_Trades_FinancialStatusBankrupt,
+	"Financial Status: Deficient":                             Financial_Trades_FinancialStatusDeficient,
+	"Financial Status: Delinquent":                            Financial_Trades_FinancialStatusDelinquent,
+	"Financial Status: Bankrupt and Deficient":                Financial_Trades_FinancialStatusBankruptAndDeficient,
+	"Financial Status: Bankrupt and Delinquent":               Financial_Trades_FinancialStatusBankruptAndDelinquent,
+	"Financial Status: Deficient and Delinquent":              Financial_Trades_FinancialStatusDeficientAndDelinquent,
+	"Financial Status: Deficient, Delinquent, Bankrupt":       Financial_Trades_FinancialStatusDeficientDelinquentBankrupt,
+	"Financial Status: Liquidation":                           Financial_Trades_FinancialStatusLiquidation,
+	"Financial Status: Creations Suspended":                   Financial_Trades_FinancialStatusCreationsSuspended,
+	"Financial Status: Redemptions Suspended":                 Financial_Trades_FinancialStatusRedemptionsSuspended,
+	"Late and Out of Sequence":                                Financial_Trades_LateAndOutOfSequence,
+	"Last and Canceled":                                       Financial_Trades_LastAndCanceled,
+	"Opening Trade and Canceled":                              Financial_Trades_OpeningTradeAndCanceled,
+	"Opening Trade, Late and Out of Sequence":                 Financial_Trades_OpeningTradeLateAndOutOfSequence,
+	"Only Trade and Canceled":                                 Financial_Trades_OnlyTradeAndCanceled,
+	"Opening Trade and Late":                                  Financial_Trades_OpeningTradeAndLate,
+	"Automatic Execution Option":                              Financial_Trades_AutomaticExecutionOption,
+	"Reopening Trade":                                         Financial_Trades_ReopeningTrade,
+	"Intermarket Sweep Order":                                 Financial_Trades_IntermarketSweepOrder,
+	"Single-Leg Auction, Non-ISO":                             Financial_Trades_SingleLegAuctionNonISO,
+	"Single-Leg Auction, ISO":                                 Financial_Trades_SingleLegAuctionISO,
+	"Single-Leg Cross, Non-ISO":                               Financial_Trades_SingleLegCrossNonISO,
+	"Single-Leg Cross, ISO":                                   Financial_Trades_SingleLegCrossISO,
+	"Single-Leg Floor Trade":                                  Financial_Trades_SingleLegFloorTrade,
+	"Multi-Leg, Auto-Electronic Trade":                        Financial_Trades_MultiLegAutoElectronicTrade,
+	"Multi-Leg Auction":                                       Financial_Trades_MultiLegAuction,
+	"Multi-Leg Cross":                                         Financial_Trades_MultiLegCross,
+	"Multi-Leg Floor Trade":                                   Financial_Trades_MultiLegFloorTrade,
+	"Multi-Leg, Auto-Electronic Trade against Single-Leg":     Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg,
+	"Stock Options Auction":                                   Financial_Trades_StockOptionsAuction,
+	"Multi-Leg Auction against Single-Leg":                    Financial_Trades_MultiLegAuctionAgainstSingleLeg,
+	"Multi-Leg Floor Trade against Single-Leg":                Financial_Trades_MultiLegFloorTradeAgainstSingleLeg,
+	"Stock Options, Auto-Electronic Trade":                    Financial_Trades_StockOptionsAutoElectronicTrade,
+	"Stock Options Cross":                                     Financial_Trades_StockOptionsCross,
+	"Stock Options Floor Trade":                               Financial_Trades_StockOptionsFloorTrade,
+	"Stock Options, Auto-Electronic Trade against Single-Leg": Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg,
+	"Stock Options, Auction against Single-Leg":               Financial_Trades_StockOptionsAuctionAgainstSingleLeg,
+	"Stock Options, Floor Trade against Single-Leg":           Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg,
+	"Multi-Leg Floor Trade of Proprietary Products":           Financial_Trades_MultiLegFloorTradeOfProprietaryProducts,
+	"Multilateral Compression Trade of Proprietary Products":  Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts,
+	"Extended Hours Trade":                                    Financial_Trades_ExtendedHoursTrade,
+	"UTP:@":                                                   Financial_Trades_RegularSale,
+	"UTP:A":                                                   Financial_Trades_Acquisition,
+	"UTP:W":                                                   Financial_Trades_AveragePriceTrade,
+	"UTP:B":                                                   Financial_Trades_BunchedTrade,
+	"UTP:G":                                                   Financial_Trades_BunchedSoldTrade,
+	"UTP:C":                                                   Financial_Trades_CashSale,
+	"UTP:6":                                                   Financial_Trades_ClosingPrints,
+	"UTP:X":                                                   Financial_Trades_CrossTrade,
+	"UTP:4":                                                   Financial_Trades_DerivativelyPriced,
+	"UTP:D":                                                   Financial_Trades_Distribution,
+	"UTP:T":                                                   Financial_Trades_FormT,
+	"UTP:U":                                                   Financial_Trades_ExtendedTradingHours,
+	"UTP:F":                                                   Financial_Trades_IntermarketSweep,
+	"UTP:M":                                                   Financial_Trades_MarketCenterOfficialClose,
+	"UTP:Q":                                                   Financial_Trades_MarketCenterOfficialOpen,
+	"UTP:N":                                                   Financial_Trades_NextDay,
+	"UTP:H":                                                   Financial_Trades_PriceVariationTrade,
+	"UTP:P":                                                   Financial_Trades_PriorReferencePrice,
+	"UTP:K":                                                   Financial_Trades_Rule155Trade,
+	"UTP:O":                                                   Financial_Trades_OpeningPrints,
+	"UTP:1":                                                   Financial_Trades_StoppedStock,
+	"UTP:R":                                                   Financial_Trades_Seller,
+	"UTP:5":                                                   Financial_Trades_ReOpeningPrints,
+	"UTP:L":                                                   Financial_Trades_SoldLast,
+	"UTP:2":                                                   Financial_Trades_SoldLastAndStoppedStock,
+	"UTP:Z":                                                   Financial_Trades_SoldOut,
+	"UTP:3":                                                   Financial_Trades_SoldOutOfSequence,
+	"UTP:S":                                                   Financial_Trades_SplitTrade,
+	"UTP:V":                                                   Financial_Trades_StockOption,
+	"UTP:Y":                                                   Financial_Trades_YellowFlagRegularTrade,
+	"UTP:I":                                                   Financial_Trades_OddLotTrade,
+	"UTP:9":                                                   Financial_Trades_CorrectedConsolidatedClose,
+	"UTP:7":                                                   Financial_Trades_QualifiedContingentTrade,
+	"CTA:@":                                                   Financial_Trades_RegularSale,
+	"CTA:B":                                                   Financial_Trades_AveragePriceTrade,
+	"CTA:E":                                                   Financial_Trades_AutomaticExecution,
+	"CTA:I":                                                   Financial_Trades_CAPElection,
+	"CTA:C":                                                   Financial_Trades_CashSale,
+	"CTA:X":                                                   Financial_Trades_CrossTrade,
+	"CTA:4":                                                   Financial_Trades_DerivativelyPriced,
+	"CTA:T":                                                   Financial_Trades_FormT,
+	"CTA:U":                                                   Financial_Trades_ExtendedTradingHours,
+	"CTA:F":                                                   Financial_Trades_IntermarketSweep,
+	"CTA:M":                                                   Financial_Trades_MarketCenterOfficialClose,
+	"CTA:Q":                                                   Financial_Trades_MarketCenterOfficialOpen,
+	"CTA:O":                                                   Financial_Trades_MarketCenterOpeningTrade,
+	"CTA:S":                                                   Financial_Trades_MarketCenterReopeningTrade,
+	"CTA:6":                                                   Financial_Trades_MarketCenterClosingTrade,
+	"CTA:N":                                                   Financial_Trades_NextDay,
+	"CTA:H":                                                   Financial_Trades_PriceVariationTrade,
+	"CTA:P":                                                   Financial_Trades_PriorReferencePrice,
+	"CTA:K":                                                   Financial_Trades_Rule155Trade,
+	"CTA:R":                                                   Financial_Trades_Seller,
+	"CTA:L":                                                   Financial_Trades_SoldLast,
+	"CTA:Z":                                                   Financial_Trades_SoldOut,
+	"CTA:9":                                                   Financial_Trades_CorrectedConsolidatedClose,
+	"CTA:1":                                                   Financial_Trades_TradeThruExempt,
+	"CTA:V":                                                   Financial_Trades_ContingentTrade,
+	"CTA:7":                                                   Financial_Trades_QualifiedContingentTrade,
+	"CTA:G":                                                   Financial_Trades_OpeningReopeningTradeDetail,
+	"CTA:A":                                                   Financial_Trades_ShortSaleRestrictionActivated,
+	"CTA:D":                                                   Financial_Trades_ShortSaleRestrictionDeactivated,
+	"CTA:2":                                                   Financial_Trades_FinancialStatusDeficient,
+	"CTA:3":                                                   Financial_Trades_FinancialStatusDelinquent,
+	"CTA:5":                                                   Financial_Trades_FinancialStatusBankruptAndDelinquent,
+	"CTA:8":                                                   Financial_Trades_FinancialStatusCreationsSuspended,
+	"FINRA_TDDS:W":                                            Financial_Trades_AveragePriceTrade,
+	"FINRA_TDDS:C":                                            Financial_Trades_CashSale,
+	"FINRA_TDDS:T":                                            Financial_Trades_FormT,
+	"FINRA_TDDS:U":                                            Financial_Trades_ExtendedTradingHours,
+	"FINRA_TDDS:N":                                            Financial_Trades_NextDay,
+	"FINRA_TDDS:P":                                            Financial_Trades_PriorReferencePrice,
+	"FINRA_TDDS:R":                                            Financial_Trades_Seller,
+	"FINRA_TDDS:Z":                                            Financial_Trades_SoldOut,
+	"FINRA_TDDS:I":                                            Financial_Trades_OddLotTrade,
+	"OPRA:A":                                                  Financial_Trades_Canceled,
+	"OPRA:B":                                                  Financial_Trades_LateAndOutOfSequence,
+	"OPRA:C":                                                  Financial_Trades_LastAndCanceled,
+	"OPRA:D":                                                  Financial_Trades_Late,
+	"OPRA:E":                                                  Financial_Trades_OpeningTradeAndCanceled,
+	"OPRA:F":                                                  Financial_Trades_OpeningTradeLateAndOutOfSequence,
+	"OPRA:G":                                                  Financial_Trades_OnlyTradeAndCanceled,
+	"OPRA:H":                                                  Financial_Trades_OpeningTradeAndLate,
+	"OPRA:I":                                                  Financial_Trades_AutomaticExecutionOption,
+	"OPRA:J":                                                  Financial_Trades_ReopeningTrade,
+	"OPRA:S":                                                  Financial_Trades_IntermarketSweepOrder,
+	"OPRA:a":                                                  Financial_Trades_SingleLegAuctionNonISO,
+	"OPRA:b":                                                  Financial_Trades_SingleLegAuctionISO,
+	"OPRA:c":                                                  Financial_Trades_SingleLegCrossNonISO,
+	"OPRA:d":                                                  Financial_Trades_SingleLegCrossISO,
+	"OPRA:e":                                                  Financial_Trades_SingleLegFloorTrade,
+	"OPRA:f":                                                  Financial_Trades_MultiLegAutoElectronicTrade,
+	"OPRA:g":                                                  Financial_Trades_MultiLegAuction,
+	"OPRA:h":                                                  Financial_Trades_MultiLegCross,
+	"OPRA:i":                                                  Financial_Trades_MultiLegFloorTrade,
+	"OPRA:j":                                                  Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg,
+	"OPRA:k":                                                  Financial_Trades_StockOptionsAuction,
+	"OPRA:l":                                                  Financial_Trades_MultiLegAuctionAgainstSingleLeg,
+	"OPRA:m":                                                  Financial_Trades_MultiLegFloorTradeAgainstSingleLeg,
+	"OPRA:n":                                                  Financial_Trades_StockOptionsAutoElectronicTrade,
+	"OPRA:o":                                                  Financial_Trades_StockOptionsCross,
+	"OPRA:p":                                                  Financial_Trades_StockOptionsFloorTrade,
+	"OPRA:q":                                                  Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg,
+	"OPRA:r":                                                  Financial_Trades_StockOptionsAuctionAgainstSingleLeg,
+	"OPRA:s":                                                  Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg,
+	"OPRA:t":                                                  Financial_Trades_MultiLegFloorTradeOfProprietaryProducts,
+	"OPRA:u":                                                  Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts,
+	"OPRA:v":                                                  Financial_Trades_ExtendedHoursTrade,
+}
+
+// TradeConditionAlternates contains alternative values for the Financial.Trades.Condition enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var TradeConditionAlternates = copyTable(tradeConditionAlternates)
+
+// tradeConditionMapping contains alternate names for the Financial.Trades.Condition enum
+var tradeConditionMapping = map[Financial_Trades_Condition]string{
+	Financial_Trades_RegularSale:                                       "Regular Sale",
+	Financial_Trades_AveragePriceTrade:                                 "Average Price Trade",
+	Financial_Trades_AutomaticExecution:                                "Automatic Execution",
+	Financial_Trades_BunchedTrade:                                      "Bunched Trade",
+	Financial_Trades_BunchedSoldTrade:                                  "Bunched Sold Trade",
+	Financial_Trades_CAPElection:                                       "CAP Election",
+	Financial_Trades_CashSale:                                          "Cash Sale",
+	Financial_Trades_ClosingPrints:                                     "Closing Prints",
+	Financial_Trades_CrossTrade:                                        "Cross Trade",
+	Financial_Trades_DerivativelyPriced:                                "Derivatively Priced",
+	Financial_Trades_FormT:                                             "Form T",
+	Financial_Trades_ExtendedTradingHours:                              "Extended Trading Hours (Sold Out of Sequence)",
+	Financial_Trades_IntermarketSweep:                                  "Intermarket Sweep",
+	Financial_Trades_MarketCenterOfficialClose:                         "Market Center Official Close",
+	Financial_Trades_MarketCenterOfficialOpen:                          "Market Center Official Open",
+	Financial_Trades_MarketCenterOpeningTrade:                          "Market Center Opening Trade",
+	Financial_Trades_MarketCenterReopeningTrade:                        "Market Center Reopening Trade",
+	Financial_Trades_MarketCenterClosingTrade:                          "Market Center Closing Trade",
+	Financial_Trades_NextDay:                                           "Next Day",
+	Financial_Trades_PriceVariationTrade:                               "Price Variation Trade",
+	Financial_Trades_PriorReferencePrice:                               "Prior Reference Price",
+	Financial_Trades_Rule155Trade:                                      "Rule 155 Trade (AMEX)",
+	Financial_Trades_Rule127NYSE:                                       "Rule 127 NYSE",
+	Financial_Trades_OpeningPrints:                                     "Opening Prints",
+	Financial_Trades_StoppedStock:                                      "Stopped Stock (Regular Trade)",
+	Financial_Trades_ReOpeningPrints:                                   "Re-Opening Prints",
+	Financial_Trades_SoldLast:                                          "Sold Last",
+	Financial_Trades_SoldLastAndStoppedStock:                           "Sold Last and Stopped Stock",
+	Financial_Trades_SoldOut:                                           "Sold Out",
+	Financial_Trades_SoldOutOfSequence:                                 "Sold (Out of Sequence)",
+	Financial_Trades_SplitTrade:                                        "Split Trade",
+	Financial_Trades_StockOption:                                       "Stock Option",
+	Financial_Trades_YellowFlagRegularTrade:                            "Yellow Flag Regular Trade",
+	Financial_Trades_OddLotTrade:                                       "Odd Lot Trade",
+	Financial_Trades_CorrectedConsolidatedClose:                        "Corrected Consolidated Close",
+	Financial_Trades_TradeThruExempt:                                   "Trade Thru Exempt",
+	Financial_Trades_NonEligible:                                       "Non-Eligible",
+	Financial_Trades_NonEligibleExtended:                               "Non-Eligible Extended",
+	Financial_Trades_AsOf:                                              "As of",
+	Financial_Trades_AsOfCorrection:                                    "As of Correction",
+	Financial_Trades_AsOfCancel:                                        "As of Cancel",
+	Financial_Trades_ContingentTrade:                                   "Contingent Trade",
+	Financial_Trades_QualifiedContingentTrade:                          "Qualified Contingent Trade (QCT)",
+	Financial_Trades_OpeningReopeningTradeDetail:                       "Opening / Reopening Trade Detail",
+	Financial_Trades_ShortSaleRestrictionActivated:                     "Short Sale Restriction Activated",
+	Financial_Trades_ShortSaleRestrictionContinued:                     "Short Sale Restriction Continued",
+	Financial_Trades_ShortSaleRestrictionDeactivated:                   "Short Sale Restriction Deactivated",
+	Financial_Trades_ShortSaleRestrictionInEffect:                      "Short Sale Restriction in Effect",
+	Financial_Trades_FinancialStatusBankrupt:                           "Financial Status: Bankrupt",
+	Financial_Trades_FinancialStatusDeficient:                          "Financial Status: Deficient",
+	Financial_Trades_FinancialStatusDelinquent:                         "Financial Status: Delinquent",
+	Financial_Trades_FinancialStatusBankruptAndDeficient:               "Financial Status: Bankrupt and Deficient",
+	Financial_Trades_FinancialStatusBankruptAndDelinquent:              "Financial Status: Bankrupt and Delinquent",
+	Financial_Trades_FinancialStatusDeficientAndDelinquent:             "Financial Status: Deficient and Delinquent",
+	Financial_Trades_FinancialStatusDeficientDelinquentBankrupt:        "Financial Status: Deficient, Delinquent, Bankrupt",
+	Financial_Trades_FinancialStatusLiquidation:                        "Financial Status: Liquidation",
+	Financial_Trades_FinancialStatusCreationsSuspended:                 "Financial Status: Creations Suspended",
+	Financial_Trades_FinancialStatusRedemptionsSuspended:               "Financial Status: Redemptions Suspended",
+	Financial_Trades_LateAndOutOfSequence:                              "Late and Out of Sequence",
+	Financial_Trades_LastAndCanceled:                                   "Last and Canceled",
+	Financial_Trades_OpeningTradeAndCanceled:                           "Opening Trade and Canceled",
+	Financial_Trades_OpeningTradeLateAndOutOfSequence:                  "Opening Trade, Late and Out of Sequence",
+	Financial_Trades_OnlyTradeAndCanceled:                              "Only Trade and Canceled",
+	Financial_Trades_OpeningTradeAndLate:                               "Opening Trade and Late",
+	Financial_Trades_AutomaticExecutionOption:                          "Automatic Execution Option",
+	Financial_Trades_ReopeningTrade:                                    "Reopening Trade",
+	Financial_Trades_IntermarketSweepOrder:                             "Intermarket Sweep Order",
+	Financial_Trades_SingleLegAuctionNonISO:                            "Single-Leg Auction, Non-ISO",
+	Financial_Trades_SingleLegAuctionISO:                               "Single-Leg Auction, ISO",
+	Financial_Trades_SingleLegCrossNonISO:                              "Single-Leg Cross, Non-ISO",
+	Financial_Trades_SingleLegCrossISO:                                 "Single-Leg Cross, ISO",
+	Financial_Trades_SingleLegFloorTrade:                               "Single-Leg Floor Trade",
+	Financial_Trades_MultiLegAutoElectronicTrade:                       "Multi-Leg, Auto-Electronic Trade",
+	Financial_Trades_MultiLegAuction:                                   "Multi-Leg Auction",
+	Financial_Trades_MultiLegCross:                                     "Multi-Leg Cross",
+	Financial_Trades_MultiLegFloorTrade:                                "Multi-Leg Floor Trade",
+	Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg:       "Multi-Leg, Auto-Electronic Trade against Single-Leg",
+	Financial_Trades_StockOptionsAuction:                               "Stock Options Auction",
+	Financial_Trades_MultiLegAuctionAgainstSingleLeg:                   "Multi-Leg Auction against Single-Leg",
+	Financial_Trades_MultiLegFloorTradeAgainstSingleLeg:                "Multi-Leg Floor Trade against Single-Leg",
+	Financial_Trades_StockOptionsAutoElectronicTrade:                   "Stock Options, Auto-Electronic Trade",
+	Financial_Trades_StockOptionsCross:                                 "Stock Options Cross",
+	Financial_Trades_StockOptionsFloorTrade:                            "Stock Options Floor Trade",
+	Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg:   "Stock Options, Auto-Electronic Trade against Single-Leg",
+	Financial_Trades_StockOptionsAuctionAgainstSingleLeg:               "Stock Options, Auction against Single-Leg",
+	Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg:            "Stock Options, Floor Trade against Single-Leg",
+	Financial_Trades_MultiLegFloorTradeOfProprietaryProducts:           "Multi-Leg Floor Trade of Proprietary Products",
+	Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts: "Multilateral Compression Trade of Proprietary Products",
+	Financial_Trades_ExtendedHoursTrade:                                "Extended Hours Trade",
+}
+
+// TradeConditionMapping contains alternate names for the Financial.Trades.Condition enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var TradeConditionMapping = copyTable(tradeConditionMapping)
+
+// tradeConditionCodes contains the codes used for the values of the Financial.Trades.Condition enum by each of the sources
+// that disseminate them. Each code is also included in the alternates, prefixed by the name of its source
+var tradeConditionCodes = map[string]map[string]Financial_Trades_Condition{
+	"UTP": {
+		"@": Financial_Trades_RegularSale,
+		"A": Financial_Trades_Acquisition,
+		"W": Financial_Trades_AveragePriceTrade,
+		"B": Financial_Trades_BunchedTrade,
+		"G": Financial_Trades_BunchedSoldTrade,
+		"C": Financial_Trades_CashSale,
+		"6": Financial_Trades_ClosingPrints,
+		"X": Financial_Trades_CrossTrade,
+		"4": Financial_Trades_DerivativelyPriced,
+		"D": Financial_Trades_Distribution,
+		"T": Financial_Trades_FormT,
+		"U": Financial_Trades_ExtendedTradingHours,
+		"F": Financial_Trades_IntermarketSweep,
+		"M": Financial_Trades_MarketCenterOfficialClose,
+		"Q": Financial_Trades_MarketCenterOfficialOpen,
+		"N": Financial_Trades_NextDay,
+		"H": Financial_Trades_PriceVariationTrade,
+		"P": Financial_Trades_PriorReferencePrice,
+		"K": Financial_Trades_Rule155Trade,
+		"O": Financial_Trades_OpeningPrints,
+		"1": Financial_Trades_StoppedStock,
+		"R": Financial_Trades_Seller,
+		"5": Financial_Trades_ReOpeningPrints,
+		"L": Financial_Trades_SoldLast,
+		"2": Financial_Trades_SoldLastAndStoppedStock,
+		"Z": Financial_Trades_SoldOut,
+		"3": Financial_Trades_SoldOutOfSequence,
+		"S": Financial_Trades_SplitTrade,
+		"V": Financial_Trades_StockOption,
+		"Y": Financial_Trades_YellowFlagRegularTrade,
+		"I": Financial_Trades_OddLotTrade,
+		"9": Financial_Trades_CorrectedConsolidatedClose,
+		"7": Financial_Trades_QualifiedContingentTrade,
+	},
+	"CTA": {
+		"@": Financial_Trades_RegularSale,
+		"B": Financial_Trades_AveragePriceTrade,
+		"E": Financial_Trades_AutomaticExecution,
+		"I": Financial_Trades_CAPElection,
+		"C": Financial_Trades_CashSale,
+		"X": Financial_Trades_CrossTrade,
+		"4": Financial_Trades_DerivativelyPriced,
+		"T": Financial_Trades_FormT,
+		"U": Financial_Trades_ExtendedTradingHours,
+		"F": Financial_Trades_IntermarketSweep,
+		"M": Financial_Trades_MarketCenterOfficialClose,
+		"Q": Financial_Trades_MarketCenterOfficialOpen,
+		"O": Financial_Trades_MarketCenterOpeningTrade,
+		"S": Financial_Trades_MarketCenterReopeningTrade,
+		"6": Financial_Trades_MarketCenterClosingTrade,
+		"N": Financial_Trades_NextDay,
+		"H": Financial_Trades_PriceVariationTrade,
+		"P": Financial_Trades_PriorReferencePrice,
+		"K": Financial_Trades_Rule155Trade,
+		"R": Financial_Trades_Seller,
+		"L": Financial_Trades_SoldLast,
+		"Z": Financial_Trades_SoldOut,
+		"9": Financial_Trades_CorrectedConsolidatedClose,
+		"1": Financial_Trades_TradeThruExempt,
+		"V": Financial_Trades_ContingentTrade,
+		"7": Financial_Trades_QualifiedContingentTrade,
+		"G": Financial_Trades_OpeningReopeningTradeDetail,
+		"A": Financial_Trades_ShortSaleRestrictionActivated,
+		"D": Financial_Trades_ShortSaleRestrictionDeactivated,
+		"2": Financial_Trades_FinancialStatusDeficient,
+		"3": Financial_Trades_FinancialStatusDelinquent,
+		"5": Financial_Trades_FinancialStatusBankruptAndDelinquent,
+		"8": Financial_Trades_FinancialStatusCreationsSuspended,
+	},
+	"FINRA_TDDS": {
+		"W": Financial_Trades_AveragePriceTrade,
+		"C": Financial_Trades_CashSale,
+		"T": Financial_Trades_FormT,
+		"U": Financial_Trades_ExtendedTradingHours,
+		"N": Financial_Trades_NextDay,
+		"P": Financial_Trades_PriorReferencePrice,
+		"R": Financial_Trades_Seller,
+		"Z": Financial_Trades_SoldOut,
+		"I": Financial_Trades_OddLotTrade,
+	},
+	"OPRA": {
+		"A": Financial_Trades_Canceled,
+		"B": Financial_Trades_LateAndOutOfSequence,
+		"C": Financial_Trades_LastAndCanceled,
+		"D": Financial_Trades_Late,
+		"E": Financial_Trades_OpeningTradeAndCanceled,
+		"F": Financial_Trades_OpeningTradeLateAndOutOfSequence,
+		"G": Financial_Trades_OnlyTradeAndCanceled,
+		"H": Financial_Trades_OpeningTradeAndLate,
+		"I": Financial_Trades_AutomaticExecutionOption,
+		"J": Financial_Trades_ReopeningTrade,
+		"S": Financial_Trades_IntermarketSweepOrder,
+		"a": Financial_Trades_SingleLegAuctionNonISO,
+		"b": Financial_Trades_SingleLegAuctionISO,
+		"c": Financial_Trades_SingleLegCrossNonISO,
+		"d": Financial_Trades_SingleLegCrossISO,
+		"e": Financial_Trades_SingleLegFloorTrade,
+		"f": Financial_Trades_MultiLegAutoElectronicTrade,
+		"g": Financial_Trades_MultiLegAuction,
+		"h": Financial_Trades_MultiLegCross,
+		"i": Financial_Trades_MultiLegFloorTrade,
+		"j": Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg,
+		"k": Financial_Trades_StockOptionsAuction,
+		"l": Financial_Trades_MultiLegAuctionAgainstSingleLeg,
+		"m": Financial_Trades_MultiLegFloorTradeAgainstSingleLeg,
+		"n": Financial_Trades_StockOptionsAutoElectronicTrade,
+		"o": Financial_Trades_StockOptionsCross,
+		"p": Financial_Trades_StockOptionsFloorTrade,
+		"q": Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg,
+		"r": Financial_Trades_StockOptionsAuctionAgainstSingleLeg,
+		"s": Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg,
+		"t": Financial_Trades_MultiLegFloorTradeOfProprietaryProducts,
+		"u": Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts,
+		"v": Financial_Trades_ExtendedHoursTrade,
+	},
+}
+
+// TradeConditionDescriptions contains the descriptions of the values of the Financial.Trades.Condition enum
+var TradeConditionDescriptions = map[Financial_Trades_Condition]string{
+	Financial_Trades_RegularSale:                                       "Regular Sale",
+	Financial_Trades_Acquisition:                                       "Acquisition",
+	Financial_Trades_AveragePriceTrade:                                 "Average Price Trade",
+	Financial_Trades_AutomaticExecution:                                "Automatic Execution",
+	Financial_Trades_BunchedTrade:                                      "Bunched Trade",
+	Financial_Trades_BunchedSoldTrade:                                  "Bunched Sold Trade",
+	Financial_Trades_CAPElection:                                       "CAP Election",
+	Financial_Trades_CashSale:                                          "Cash Sale",
+	Financial_Trades_ClosingPrints:                                     "Closing Prints",
+	Financial_Trades_CrossTrade:                                        "Cross Trade",
+	Financial_Trades_DerivativelyPriced:                                "Derivatively Priced",
+	Financial_Trades_Distribution:                                      "Distribution",
+	Financial_Trades_FormT:                                             "Form T",
+	Financial_Trades_ExtendedTradingHours:                              "Extended Trading Hours (Sold Out of Sequence)",
+	Financial_Trades_IntermarketSweep:                                  "Intermarket Sweep",
+	Financial_Trades_MarketCenterOfficialClose:                         "Market Center Official Close",
+	Financial_Trades_MarketCenterOfficialOpen:                          "Market Center Official Open",
+	Financial_Trades_MarketCenterOpeningTrade:                          "Market Center Opening Trade",
+	Financial_Trades_MarketCenterReopeningTrade:                        "Market Center Reopening Trade",
+	Financial_Trades_MarketCenterClosingTrade:                          "Market Center Closing Trade",
+	Financial_Trades_NextDay:                                           "Next Day",
+	Financial_Trades_PriceVariationTrade:                               "Price Variation Trade",
+	Financial_Trades_PriorReferencePrice:                               "Prior Reference Price",
+	Financial_Trades_Rule155Trade:                                      "Rule 155 Trade (AMEX)",
+	Financial_Trades_Rule127NYSE:                                       "Rule 127 NYSE",
+	Financial_Trades_OpeningPrints:                                     "Opening Prints",
+	Financial_Trades_Opened:                                            "Opened",
+	Financial_Trades_StoppedStock:                                      "Stopped Stock (Regular Trade)",
+	Financial_Trades_ReOpeningPrints:                                   "Re-Opening Prints",
+	Financial_Trades_Seller:                                            "Seller",
+	Financial_Trades_SoldLast:                                          "Sold Last",
+	Financial_Trades_SoldLastAndStoppedStock:                           "Sold Last and Stopped Stock",
+	Financial_Trades_SoldOut:                                           "Sold Out",
+	Financial_Trades_SoldOutOfSequence:                                 "Sold (Out of Sequence)",
+	Financial_Trades_SplitTrade:                                        "Split Trade",
+	Financial_Trades_StockOption:                                       "Stock Option",
+	Financial_Trades_YellowFlagRegularTrade:                            "Yellow Flag Regular Trade",
+	Financial_Trades_OddLotTrade:                                       "Odd Lot Trade",
+	Financial_Trades_CorrectedConsolidatedClose:                        "Corrected Consolidated Close (per listing market)",
+	Financial_Trades_Unknown:                                           "Unknown",
+	Financial_Trades_Held:                                              "Held",
+	Financial_Trades_TradeThruExempt:                                   "Trade Thru Exempt",
+	Financial_Trades_NonEligible:                                       "Non-Eligible",
+	Financial_Trades_NonEligibleExtended:                               "Non-Eligible Extended",
+	Financial_Trades_Cancelled:                                         "Cancelled",
+	Financial_Trades_Recovery:                                          "Recovery",
+	Financial_Trades_Correction:                                        "Correction",
+	Financial_Trades_AsOf:                                              "As of",
+	Financial_Trades_AsOfCorrection:                                    "As of Correction",
+	Financial_Trades_AsOfCancel:                                        "As of Cancel",
+	Financial_Trades_OOB:                                               "OOB",
+	Financial_Trades_Summary:                                           "Summary",
+	Financial_Trades_ContingentTrade:                                   "Contingent Trade",
+	Financial_Trades_QualifiedContingentTrade:                          "Qualified Contingent Trade (QCT)",
+	Financial_Trades_Errored:                                           "Errored",
+	Financial_Trades_OpeningReopeningTradeDetail:                       "OPENING_REOPENING_TRADE_DETAIL",
+	Financial_Trades_Placeholder:                                       "Placeholder",
+	Financial_Trades_ShortSaleRestrictionActivated:                     "Short Sale Restriction Activated",
+	Financial_Trades_ShortSaleRestrictionContinued:                     "Short Sale Restriction Continued",
+	Financial_Trades_ShortSaleRestrictionDeactivated:                   "Short Sale Restriction Deactivated",
+	Financial_Trades_ShortSaleRestrictionInEffect:                      "Short Sale Restriction In Effect",
+	Financial_Trades_FinancialStatusBankrupt:                           "Financial Status - Bankrupt",
+	Financial_Trades_FinancialStatusDeficient:                          "Financial Status - Deficient",
+	Financial_Trades_FinancialStatusDelinquent:                         "Financial Status - Delinquent",
+	Financial_Trades_FinancialStatusBankruptAndDeficient:               "Financial Status - Bankrupt and Deficient",
+	Financial_Trades_FinancialStatusBankruptAndDelinquent:              "Financial Status - Bankrupt and Delinquent",
+	Financial_Trades_FinancialStatusDeficientAndDelinquent:             "Financial Status - Deficient and Delinquent",
+	Financial_Trades_FinancialStatusDeficientDelinquentBankrupt:        "Financial Status - Deficient, Delinquent, and Bankrupt",
+	Financial_Trades_FinancialStatusLiquidation:                        "Financial Status - Liquidation",
+	Financial_Trades_FinancialStatusCreationsSuspended:                 "Financial Status - Creations Suspended",
+	Financial_Trades_FinancialStatusRedemptionsSuspended:               "Financial Status - Redemptions Suspended",
+	Financial_Trades_Canceled:                                          "Canceled",
+	Financial_Trades_LateAndOutOfSequence:                              "Late and Out Of Sequence",
+	Financial_Trades_LastAndCanceled:                                   "Last and Canceled",
+	Financial_Trades_Late:                                              "Late",
+	Financial_Trades_OpeningTradeAndCanceled:                           "Opening Trade and Canceled",
+	Financial_Trades_OpeningTradeLateAndOutOfSequence:                  "Opening Trade, Late, and Out Of Sequence",
+	Financial_Trades_OnlyTradeAndCanceled:                              "Only Trade and Canceled",
+	Financial_Trades_OpeningTradeAndLate:                               "Opening Trade and Late",
+	Financial_Trades_AutomaticExecutionOption:                          "Automatic Execution (options)",
+	Financial_Trades_ReopeningTrade:                                    "Reopening Trade",
+	Financial_Trades_IntermarketSweepOrder:                             "Intermarket Sweep Order",
+	Financial_Trades_SingleLegAuctionNonISO:                            "Single Leg Auction Non ISO",
+	Financial_Trades_SingleLegAuctionISO:                               "Single Leg Auction ISO",
+	Financial_Trades_SingleLegCrossNonISO:                              "Single Leg Cross Non ISO",
+	Financial_Trades_SingleLegCrossISO:                                 "Single Leg Cross ISO",
+	Financial_Trades_SingleLegFloorTrade:                               "Single Leg Floor Trade",
+	Financial_Trades_MultiLegAutoElectronicTrade:                       "Multi Leg auto-electronic trade",
+	Financial_Trades_MultiLegAuction:                                   "Multi Leg Auction",
+	Financial_Trades_MultiLegCross:                                     "Multi Leg Cross",
+	Financial_Trades_MultiLegFloorTrade:                                "Multi Leg floor trade",
+	Financial_Trades_MultiLegAutoElectronicTradeAgainstSingleLeg:       "Multi Leg auto-electronic trade against single leg(s)",
+	Financial_Trades_StockOptionsAuction:                               "Stock Options Auction",
+	Financial_Trades_MultiLegAuctionAgainstSingleLeg:                   "Multi Leg Auction against single leg(s)",
+	Financial_Trades_MultiLegFloorTradeAgainstSingleLeg:                "Multi Leg floor trade against single leg(s)",
+	Financial_Trades_StockOptionsAutoElectronicTrade:                   "Stock Options auto-electronic trade",
+	Financial_Trades_StockOptionsCross:                                 "Stock Options Cross",
+	Financial_Trades_StockOptionsFloorTrade:                            "Stock Options floor trade",
+	Financial_Trades_StockOptionsAutoElectronicTradeAgainstSingleLeg:   "Stock Options auto-electronic trade against single leg(s)",
+	Financial_Trades_StockOptionsAuctionAgainstSingleLeg:               "Stock Options Auction against single leg(s)",
+	Financial_Trades_StockOptionsFloorTradeAgainstSingleLeg:            "Stock Options floor trade against single leg(s)",
+	Financial_Trades_MultiLegFloorTradeOfProprietaryProducts:           "Multi Leg Floor Trade of Proprietary Products",
+	Financial_Trades_MultilateralCompressionTradeOfProprietaryProducts: "Multilateral Compression Trade of Proprietary Products",
+	Financial_Trades_ExtendedHoursTrade:                                "Extended Hours Trade",
+}
+
+// tradeCorrectionAlternates contains alternative values for the Financial.Trades.CorrectionCode enum
+var tradeCorrectionAlternates = map[string]Financial_Trades_CorrectionCode{
+	"Not Corrected":     Financial_Trades_NotCorrected,
+	"Late, Corrected":   Financial_Trades_LateCorrected,
+	"Cancelled":         Financial_Trades_Cancel,
+	"Cancel Record":     Financial_Trades_CancelRecord,
+	"Error Record":      Financial_Trades_ErrorRecord,
+	"Correction Record": Financial_Trades_CorrectionRecord,
+	"00":                Financial_Trades_NotCorrected,
+	"01":                Financial_Trades_LateCorrected,
+	"07":                Financial_Trades_Erroneous,
+	"08":                Financial_Trades_Cancel,
+}
+
+// TradeCorrectionAlternates contains alternative values for the Financial.Trades.CorrectionCode enum
+//
+// Deprecated: This is a copy of the table used to decode the enum, so modifying it has no effect. Use
+// utils.Describe to read the alternate names of a value, or RegisterAliases to add names for a provider
+var TradeCorrectionAlternates = copyTable(tradeCorrectionAlternates)
+
+// tradeCorrectionMapping contains alternate names for the Financial.Trades.CorrectionCode enum
+var tradeCorrectionMapping = map[Financial_Trades_CorrectionCode]string{
+	Financial_Trades_NotCorrected:     "Not Corrected",
+	Financial_Trades_LateCorrected:    "Late, Corrected",
+	Financial_Trades_Cancel:           "Cancelled",
+	Financial_Trades_CancelRecord:     "Cancel Record",
+	Financial_Trades_ErrorRecord:      "Error Record",
+	Financial_Trades_CorrectionRecord: "Correction Record",
+}
+
+// TradeCorrectionMapping contains alternate names for the Financial.Trades.CorrectionCode enum
+//
+// Deprecated: This is a copy of the table used to encode the enum, so modifying it has no effect. Use
+// utils.Describe to read the output name of a value, or RegisterAliases to add names for a provider
+var TradeCorrectionMapping = copyTable(tradeCorrectionMapping)
+
+// TradeCorrectionDescriptions contains the descriptions of the values of the Financial.Trades.CorrectionCode enum
+var TradeCorrectionDescriptions = map[Financial_Trades_CorrectionCode]string{
+	Financial_Trades_NotCorrected:     "00: Regular trade which was not corrected, changed or signified as cacel or error",
+	Financial_Trades_LateCorrected:    "01: Original trade which was later corrected (This record contains the originl",
+	Financial_Trades_Erroneous:        "07: Original trade which was later marked as erroreous",
+	Financial_Trades_Cancel:           "08: Original trade which was later cancelled",
+	Financial_Trades_CancelRecord:     "10: Cancel record (This record follows '08' records)",
+	Financial_Trades_ErrorRecord:      "11: Error record (This record follows '07' records)",
+	Financial_Trades_CorrectionRecord: "12: Correction record (This record follows '01' records and contains the",
+}
+
+// providerCodec converts a Provider to and from each of the supported formats
+var providerCodec = utils.NewEnumCodec[Provider](providerAlternates, providerMapping).
+	WithDescriptions(ProviderDescriptions)
+
+// MarshalJSON converts a Provider to JSON
+func (enum Provider) MarshalJSON() ([]byte, error) {
+	return providerCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Provider to a CSV cell value
+func (enum Provider) MarshalCSV() (string, error) {
+	return providerCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Provider to text
+func (enum Provider) MarshalText() ([]byte, error) {
+	return providerCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Provider to a YAML node value
+func (enum Provider) MarshalYAML() (interface{}, error) {
+	return providerCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Provider to an XML element
+func (enum Provider) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return providerCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Provider to an XML attribute
+func (enum Provider) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return providerCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Provider to a BSON value
+func (enum Provider) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return providerCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Provider to a DynamoDB attribute value
+func (enum Provider) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return providerCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Provider to an SQL value
+func (enum Provider) Value() (driver.Value, error) {
+	return providerCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Provider
+func (enum *Provider) UnmarshalJSON(raw []byte) error {
+	return providerCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Provider
+func (enum *Provider) UnmarshalCSV(raw string) error {
+	return providerCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Provider
+func (enum *Provider) UnmarshalText(raw []byte) error {
+	return providerCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Provider
+func (enum *Provider) UnmarshalYAML(value *yaml.Node) error {
+	return providerCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Provider
+func (enum *Provider) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return providerCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Provider
+func (enum *Provider) UnmarshalXMLAttr(attr xml.Attr) error {
+	return providerCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Provider
+func (enum *Provider) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return providerCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Provider
+func (enum *Provider) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return providerCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Provider
+func (enum *Provider) Scan(value interface{}) error {
+	return providerCodec.DecodeSQL(value, enum)
+}
+
+// assetClassCodec converts a Financial.Common.AssetClass to and from each of the supported formats
+var assetClassCodec = utils.NewEnumCodec[Financial_Common_AssetClass](assetClassAlternates, assetClassMapping).
+	WithCSV(utils.FormatNumber[Financial_Common_AssetClass]).
+	WithSQL(utils.NumberValue[Financial_Common_AssetClass]).
+	WithDescriptions(AssetClassDescriptions)
+
+// MarshalJSON converts a Financial.Common.AssetClass to JSON
+func (enum Financial_Common_AssetClass) MarshalJSON() ([]byte, error) {
+	return assetClassCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Common.AssetClass to a CSV cell value
+func (enum Financial_Common_AssetClass) MarshalCSV() (string, error) {
+	return assetClassCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Common.AssetClass to text
+func (enum Financial_Common_AssetClass) MarshalText() ([]byte, error) {
+	return assetClassCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Common.AssetClass to a YAML node value
+func (enum Financial_Common_AssetClass) MarshalYAML() (interface{}, error) {
+	return assetClassCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Common.AssetClass to an XML element
+func (enum Financial_Common_AssetClass) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return assetClassCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Common.AssetClass to an XML attribute
+func (enum Financial_Common_AssetClass) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return assetClassCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Common.AssetClass to a BSON value
+func (enum Financial_Common_AssetClass) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return assetClassCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Common.AssetClass to a DynamoDB attribute value
+func (enum Financial_Common_AssetClass) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return assetClassCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Common.AssetClass to an SQL value
+func (enum Financial_Common_AssetClass) Value() (driver.Value, error) {
+	return assetClassCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalJSON(raw []byte) error {
+	return assetClassCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalCSV(raw string) error {
+	return assetClassCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalText(raw []byte) error {
+	return assetClassCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalYAML(value *yaml.Node) error {
+	return assetClassCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return assetClassCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalXMLAttr(attr xml.Attr) error {
+	return assetClassCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return assetClassCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return assetClassCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Common.AssetClass
+func (enum *Financial_Common_AssetClass) Scan(value interface{}) error {
+	return assetClassCodec.DecodeSQL(value, enum)
+}
+
+// assetTypeCodec converts a Financial.Common.AssetType to and from each of the supported formats
+var assetTypeCodec = utils.NewEnumCodec[Financial_Common_AssetType](assetTypeAlternates, assetTypeMapping).
+	WithCSV(utils.FormatNumber[Financial_Common_AssetType]).
+	WithSQL(utils.NumberValue[Financial_Common_AssetType])
+
+// MarshalJSON converts a Financial.Common.AssetType to JSON
+func (enum Financial_Common_AssetType) MarshalJSON() ([]byte, error) {
+	return assetTypeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Common.AssetType to a CSV cell value
+func (enum Financial_Common_AssetType) MarshalCSV() (string, error) {
+	return assetTypeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Common.AssetType to text
+func (enum Financial_Common_AssetType) MarshalText() ([]byte, error) {
+	return assetTypeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Common.AssetType to a YAML node value
+func (enum Financial_Common_AssetType) MarshalYAML() (interface{}, error) {
+	return assetTypeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Common.AssetType to an XML element
+func (enum Financial_Common_AssetType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return assetTypeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Common.AssetType to an XML attribute
+func (enum Financial_Common_AssetType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return assetTypeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Common.AssetType to a BSON value
+func (enum Financial_Common_AssetType) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return assetTypeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Common.AssetType to a DynamoDB attribute value
+func (enum Financial_Common_AssetType) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return assetTypeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Common.AssetType to an SQL value
+func (enum Financial_Common_AssetType) Value() (driver.Value, error) {
+	return assetTypeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalJSON(raw []byte) error {
+	return assetTypeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalCSV(raw string) error {
+	return assetTypeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalText(raw []byte) error {
+	return assetTypeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalYAML(value *yaml.Node) error {
+	return assetTypeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return assetTypeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalXMLAttr(attr xml.Attr) error {
+	return assetTypeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return assetTypeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return assetTypeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Common.AssetType
+func (enum *Financial_Common_AssetType) Scan(value interface{}) error {
+	return assetTypeCodec.DecodeSQL(value, enum)
+}
+
+// localeCodec converts a Financial.Common.Locale to and from each of the supported formats
+var localeCodec = utils.NewEnumCodec[Financial_Common_Locale](localeAlternates, nil).
+	WithCSV(utils.FormatNumber[Financial_Common_Locale]).
+	WithSQL(utils.NumberValue[Financial_Common_Locale]).
+	WithDescriptions(LocaleDescriptions)
+
+// MarshalJSON converts a Financial.Common.Locale to JSON
+func (enum Financial_Common_Locale) MarshalJSON() ([]byte, error) {
+	return localeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Common.Locale to a CSV cell value
+func (enum Financial_Common_Locale) MarshalCSV() (string, error) {
+	return localeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Common.Locale to text
+func (enum Financial_Common_Locale) MarshalText() ([]byte, error) {
+	return localeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Common.Locale to a YAML node value
+func (enum Financial_Common_Locale) MarshalYAML() (interface{}, error) {
+	return localeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Common.Locale to an XML element
+func (enum Financial_Common_Locale) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return localeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Common.Locale to an XML attribute
+func (enum Financial_Common_Locale) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return localeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Common.Locale to a BSON value
+func (enum Financial_Common_Locale) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return localeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Common.Locale to a DynamoDB attribute value
+func (enum Financial_Common_Locale) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return localeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Common.Locale to an SQL value
+func (enum Financial_Common_Locale) Value() (driver.Value, error) {
+	return localeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalJSON(raw []byte) error {
+	return localeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalCSV(raw string) error {
+	return localeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalText(raw []byte) error {
+	return localeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalYAML(value *yaml.Node) error {
+	return localeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return localeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalXMLAttr(attr xml.Attr) error {
+	return localeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return localeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return localeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Common.Locale
+func (enum *Financial_Common_Locale) Scan(value interface{}) error {
+	return localeCodec.DecodeSQL(value, enum)
+}
+
+// tapeCodec converts a Financial.Common.Tape to and from each of the supported formats
+var tapeCodec = utils.NewEnumCodec[Financial_Common_Tape](nil, nil).
+	WithDescriptions(TapeDescriptions)
+
+// MarshalJSON converts a Financial.Common.Tape to JSON
+func (enum Financial_Common_Tape) MarshalJSON() ([]byte, error) {
+	return tapeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Common.Tape to a CSV cell value
+func (enum Financial_Common_Tape) MarshalCSV() (string, error) {
+	return tapeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Common.Tape to text
+func (enum Financial_Common_Tape) MarshalText() ([]byte, error) {
+	return tapeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Common.Tape to a YAML node value
+func (enum Financial_Common_Tape) MarshalYAML() (interface{}, error) {
+	return tapeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Common.Tape to an XML element
+func (enum Financial_Common_Tape) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return tapeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Common.Tape to an XML attribute
+func (enum Financial_Common_Tape) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return tapeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Common.Tape to a BSON value
+func (enum Financial_Common_Tape) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return tapeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Common.Tape to a DynamoDB attribute value
+func (enum Financial_Common_Tape) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return tapeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Common.Tape to an SQL value
+func (enum Financial_Common_Tape) Value() (driver.Value, error) {
+	return tapeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalJSON(raw []byte) error {
+	return tapeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalCSV(raw string) error {
+	return tapeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalText(raw []byte) error {
+	return tapeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalYAML(value *yaml.Node) error {
+	return tapeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return tapeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalXMLAttr(attr xml.Attr) error {
+	return tapeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return tapeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return tapeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Common.Tape
+func (enum *Financial_Common_Tape) Scan(value interface{}) error {
+	return tapeCodec.DecodeSQL(value, enum)
+}
+
+// dividendFrequencyCodec converts a Financial.Dividends.Frequency to and from each of the supported formats
+var dividendFrequencyCodec = utils.NewEnumCodec[Financial_Dividends_Frequency](dividendFrequencyAlternates, dividendFrequencyMapping).
+	WithCSV(utils.FormatNumber[Financial_Dividends_Frequency]).
+	WithSQL(utils.NumberValue[Financial_Dividends_Frequency]).
+	WithDescriptions(DividendFrequencyDescriptions)
+
+// MarshalJSON converts a Financial.Dividends.Frequency to JSON
+func (enum Financial_Dividends_Frequency) MarshalJSON() ([]byte, error) {
+	return dividendFrequencyCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Dividends.Frequency to a CSV cell value
+func (enum Financial_Dividends_Frequency) MarshalCSV() (string, error) {
+	return dividendFrequencyCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Dividends.Frequency to text
+func (enum Financial_Dividends_Frequency) MarshalText() ([]byte, error) {
+	return dividendFrequencyCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Dividends.Frequency to a YAML node value
+func (enum Financial_Dividends_Frequency) MarshalYAML() (interface{}, error) {
+	return dividendFrequencyCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Dividends.Frequency to an XML element
+func (enum Financial_Dividends_Frequency) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return dividendFrequencyCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Dividends.Frequency to an XML attribute
+func (enum Financial_Dividends_Frequency) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return dividendFrequencyCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Dividends.Frequency to a BSON value
+func (enum Financial_Dividends_Frequency) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return dividendFrequencyCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Dividends.Frequency to a DynamoDB attribute value
+func (enum Financial_Dividends_Frequency) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return dividendFrequencyCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Dividends.Frequency to an SQL value
+func (enum Financial_Dividends_Frequency) Value() (driver.Value, error) {
+	return dividendFrequencyCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalJSON(raw []byte) error {
+	return dividendFrequencyCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalCSV(raw string) error {
+	return dividendFrequencyCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalText(raw []byte) error {
+	return dividendFrequencyCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalYAML(value *yaml.Node) error {
+	return dividendFrequencyCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return dividendFrequencyCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dividendFrequencyCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return dividendFrequencyCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return dividendFrequencyCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Dividends.Frequency
+func (enum *Financial_Dividends_Frequency) Scan(value interface{}) error {
+	return dividendFrequencyCodec.DecodeSQL(value, enum)
+}
+
+// dividendTypeCodec converts a Financial.Dividends.Type to and from each of the supported formats
+var dividendTypeCodec = utils.NewEnumCodec[Financial_Dividends_Type](nil, nil)
+
+// MarshalJSON converts a Financial.Dividends.Type to JSON
+func (enum Financial_Dividends_Type) MarshalJSON() ([]byte, error) {
+	return dividendTypeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Dividends.Type to a CSV cell value
+func (enum Financial_Dividends_Type) MarshalCSV() (string, error) {
+	return dividendTypeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Dividends.Type to text
+func (enum Financial_Dividends_Type) MarshalText() ([]byte, error) {
+	return dividendTypeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Dividends.Type to a YAML node value
+func (enum Financial_Dividends_Type) MarshalYAML() (interface{}, error) {
+	return dividendTypeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Dividends.Type to an XML element
+func (enum Financial_Dividends_Type) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return dividendTypeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Dividends.Type to an XML attribute
+func (enum Financial_Dividends_Type) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return dividendTypeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Dividends.Type to a BSON value
+func (enum Financial_Dividends_Type) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return dividendTypeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Dividends.Type to a DynamoDB attribute value
+func (enum Financial_Dividends_Type) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return dividendTypeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Dividends.Type to an SQL value
+func (enum Financial_Dividends_Type) Value() (driver.Value, error) {
+	return dividendTypeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalJSON(raw []byte) error {
+	return dividendTypeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalCSV(raw string) error {
+	return dividendTypeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalText(raw []byte) error {
+	return dividendTypeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalYAML(value *yaml.Node) error {
+	return dividendTypeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return dividendTypeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dividendTypeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return dividendTypeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return dividendTypeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Dividends.Type
+func (enum *Financial_Dividends_Type) Scan(value interface{}) error {
+	return dividendTypeCodec.DecodeSQL(value, enum)
+}
+
+// exchangeTypeCodec converts a Financial.Exchanges.Type to and from each of the supported formats
+var exchangeTypeCodec = utils.NewEnumCodec[Financial_Exchanges_Type](exchangeTypeAlternates, nil).
+	WithCSV(utils.FormatNumber[Financial_Exchanges_Type]).
+	WithSQL(utils.NumberValue[Financial_Exchanges_Type])
+
+// MarshalJSON converts a Financial.Exchanges.Type to JSON
+func (enum Financial_Exchanges_Type) MarshalJSON() ([]byte, error) {
+	return exchangeTypeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Exchanges.Type to a CSV cell value
+func (enum Financial_Exchanges_Type) MarshalCSV() (string, error) {
+	return exchangeTypeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Exchanges.Type to text
+func (enum Financial_Exchanges_Type) MarshalText() ([]byte, error) {
+	return exchangeTypeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Exchanges.Type to a YAML node value
+func (enum Financial_Exchanges_Type) MarshalYAML() (interface{}, error) {
+	return exchangeTypeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Exchanges.Type to an XML element
+func (enum Financial_Exchanges_Type) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return exchangeTypeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Exchanges.Type to an XML attribute
+func (enum Financial_Exchanges_Type) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return exchangeTypeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Exchanges.Type to a BSON value
+func (enum Financial_Exchanges_Type) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return exchangeTypeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Exchanges.Type to a DynamoDB attribute value
+func (enum Financial_Exchanges_Type) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return exchangeTypeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Exchanges.Type to an SQL value
+func (enum Financial_Exchanges_Type) Value() (driver.Value, error) {
+	return exchangeTypeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalJSON(raw []byte) error {
+	return exchangeTypeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalCSV(raw string) error {
+	return exchangeTypeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalText(raw []byte) error {
+	return exchangeTypeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalYAML(value *yaml.Node) error {
+	return exchangeTypeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return exchangeTypeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalXMLAttr(attr xml.Attr) error {
+	return exchangeTypeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return exchangeTypeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return exchangeTypeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Exchanges.Type
+func (enum *Financial_Exchanges_Type) Scan(value interface{}) error {
+	return exchangeTypeCodec.DecodeSQL(value, enum)
+}
+
+// optionContractTypeCodec converts a Financial.Options.ContractType to and from each of the supported formats
+var optionContractTypeCodec = utils.NewEnumCodec[Financial_Options_ContractType](optionContractTypeAlternates, nil).
+	WithCSV(utils.FormatNumber[Financial_Options_ContractType])
+
+// MarshalJSON converts a Financial.Options.ContractType to JSON
+func (enum Financial_Options_ContractType) MarshalJSON() ([]byte, error) {
+	return optionContractTypeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Options.ContractType to a CSV cell value
+func (enum Financial_Options_ContractType) MarshalCSV() (string, error) {
+	return optionContractTypeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Options.ContractType to text
+func (enum Financial_Options_ContractType) MarshalText() ([]byte, error) {
+	return optionContractTypeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Options.ContractType to a YAML node value
+func (enum Financial_Options_ContractType) MarshalYAML() (interface{}, error) {
+	return optionContractTypeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Options.ContractType to an XML element
+func (enum Financial_Options_ContractType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return optionContractTypeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Options.ContractType to an XML attribute
+func (enum Financial_Options_ContractType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return optionContractTypeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Options.ContractType to a BSON value
+func (enum Financial_Options_ContractType) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return optionContractTypeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Options.ContractType to a DynamoDB attribute value
+func (enum Financial_Options_ContractType) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return optionContractTypeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Options.ContractType to an SQL value
+func (enum Financial_Options_ContractType) Value() (driver.Value, error) {
+	return optionContractTypeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalJSON(raw []byte) error {
+	return optionContractTypeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalCSV(raw string) error {
+	return optionContractTypeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalText(raw []byte) error {
+	return optionContractTypeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalYAML(value *yaml.Node) error {
+	return optionContractTypeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return optionContractTypeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalXMLAttr(attr xml.Attr) error {
+	return optionContractTypeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return optionContractTypeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return optionContractTypeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Options.ContractType
+func (enum *Financial_Options_ContractType) Scan(value interface{}) error {
+	return optionContractTypeCodec.DecodeSQL(value, enum)
+}
+
+// optionExerciseStyleCodec converts a Financial.Options.ExerciseStyle to and from each of the supported formats
+var optionExerciseStyleCodec = utils.NewEnumCodec[Financial_Options_ExerciseStyle](optionExerciseStyleAlternates, nil).
+	WithCSV(utils.FormatNumber[Financial_Options_ExerciseStyle])
+
+// MarshalJSON converts a Financial.Options.ExerciseStyle to JSON
+func (enum Financial_Options_ExerciseStyle) MarshalJSON() ([]byte, error) {
+	return optionExerciseStyleCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Options.ExerciseStyle to a CSV cell value
+func (enum Financial_Options_ExerciseStyle) MarshalCSV() (string, error) {
+	return optionExerciseStyleCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Options.ExerciseStyle to text
+func (enum Financial_Options_ExerciseStyle) MarshalText() ([]byte, error) {
+	return optionExerciseStyleCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Options.ExerciseStyle to a YAML node value
+func (enum Financial_Options_ExerciseStyle) MarshalYAML() (interface{}, error) {
+	return optionExerciseStyleCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Options.ExerciseStyle to an XML element
+func (enum Financial_Options_ExerciseStyle) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return optionExerciseStyleCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Options.ExerciseStyle to an XML attribute
+func (enum Financial_Options_ExerciseStyle) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return optionExerciseStyleCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Options.ExerciseStyle to a BSON value
+func (enum Financial_Options_ExerciseStyle) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return optionExerciseStyleCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Options.ExerciseStyle to a DynamoDB attribute value
+func (enum Financial_Options_ExerciseStyle) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return optionExerciseStyleCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Options.ExerciseStyle to an SQL value
+func (enum Financial_Options_ExerciseStyle) Value() (driver.Value, error) {
+	return optionExerciseStyleCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalJSON(raw []byte) error {
+	return optionExerciseStyleCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalCSV(raw string) error {
+	return optionExerciseStyleCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalText(raw []byte) error {
+	return optionExerciseStyleCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalYAML(value *yaml.Node) error {
+	return optionExerciseStyleCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return optionExerciseStyleCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalXMLAttr(attr xml.Attr) error {
+	return optionExerciseStyleCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return optionExerciseStyleCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return optionExerciseStyleCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Options.ExerciseStyle
+func (enum *Financial_Options_ExerciseStyle) Scan(value interface{}) error {
+	return optionExerciseStyleCodec.DecodeSQL(value, enum)
+}
+
+// optionUnderlyingTypeCodec converts a Financial.Options.UnderlyingType to and from each of the supported formats
+var optionUnderlyingTypeCodec = utils.NewEnumCodec[Financial_Options_UnderlyingType](optionUnderlyingTypeAlternates, nil).
+	WithCSV(utils.FormatNumber[Financial_Options_UnderlyingType])
+
+// MarshalJSON converts a Financial.Options.UnderlyingType to JSON
+func (enum Financial_Options_UnderlyingType) MarshalJSON() ([]byte, error) {
+	return optionUnderlyingTypeCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Options.UnderlyingType to a CSV cell value
+func (enum Financial_Options_UnderlyingType) MarshalCSV() (string, error) {
+	return optionUnderlyingTypeCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Options.UnderlyingType to text
+func (enum Financial_Options_UnderlyingType) MarshalText() ([]byte, error) {
+	return optionUnderlyingTypeCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Options.UnderlyingType to a YAML node value
+func (enum Financial_Options_UnderlyingType) MarshalYAML() (interface{}, error) {
+	return optionUnderlyingTypeCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Options.UnderlyingType to an XML element
+func (enum Financial_Options_UnderlyingType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return optionUnderlyingTypeCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Options.UnderlyingType to an XML attribute
+func (enum Financial_Options_UnderlyingType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return optionUnderlyingTypeCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Options.UnderlyingType to a BSON value
+func (enum Financial_Options_UnderlyingType) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return optionUnderlyingTypeCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Options.UnderlyingType to a DynamoDB attribute value
+func (enum Financial_Options_UnderlyingType) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return optionUnderlyingTypeCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Options.UnderlyingType to an SQL value
+func (enum Financial_Options_UnderlyingType) Value() (driver.Value, error) {
+	return optionUnderlyingTypeCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalJSON(raw []byte) error {
+	return optionUnderlyingTypeCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalCSV(raw string) error {
+	return optionUnderlyingTypeCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalText(raw []byte) error {
+	return optionUnderlyingTypeCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalYAML(value *yaml.Node) error {
+	return optionUnderlyingTypeCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return optionUnderlyingTypeCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalXMLAttr(attr xml.Attr) error {
+	return optionUnderlyingTypeCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return optionUnderlyingTypeCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return optionUnderlyingTypeCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Options.UnderlyingType
+func (enum *Financial_Options_UnderlyingType) Scan(value interface{}) error {
+	return optionUnderlyingTypeCodec.DecodeSQL(value, enum)
+}
+
+// quoteConditionCodec converts a Financial.Quotes.Condition to and from each of the supported formats
+var quoteConditionCodec = utils.NewEnumCodec[Financial_Quotes_Condition](quoteConditionAlternates, quoteConditionMapping).
+	WithCSV(utils.FormatNumber[Financial_Quotes_Condition]).
+	WithSQL(quoteConditionValue).
+	WithDescriptions(QuoteConditionDescriptions)
+
+// MarshalJSON converts a Financial.Quotes.Condition to JSON
+func (enum Financial_Quotes_Condition) MarshalJSON() ([]byte, error) {
+	return quoteConditionCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Quotes.Condition to a CSV cell value
+func (enum Financial_Quotes_Condition) MarshalCSV() (string, error) {
+	return quoteConditionCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Quotes.Condition to text
+func (enum Financial_Quotes_Condition) MarshalText() ([]byte, error) {
+	return quoteConditionCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Quotes.Condition to a YAML node value
+func (enum Financial_Quotes_Condition) MarshalYAML() (interface{}, error) {
+	return quoteConditionCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Quotes.Condition to an XML element
+func (enum Financial_Quotes_Condition) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return quoteConditionCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Quotes.Condition to an XML attribute
+func (enum Financial_Quotes_Condition) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return quoteConditionCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Quotes.Condition to a BSON value
+func (enum Financial_Quotes_Condition) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return quoteConditionCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Quotes.Condition to a DynamoDB attribute value
+func (enum Financial_Quotes_Condition) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return quoteConditionCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Quotes.Condition to an SQL value
+func (enum Financial_Quotes_Condition) Value() (driver.Value, error) {
+	return quoteConditionCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalJSON(raw []byte) error {
+	return quoteConditionCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalCSV(raw string) error {
+	return quoteConditionCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalText(raw []byte) error {
+	return quoteConditionCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalYAML(value *yaml.Node) error {
+	return quoteConditionCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return quoteConditionCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalXMLAttr(attr xml.Attr) error {
+	return quoteConditionCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return quoteConditionCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return quoteConditionCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Quotes.Condition
+func (enum *Financial_Quotes_Condition) Scan(value interface{}) error {
+	return quoteConditionCodec.DecodeSQL(value, enum)
+}
+
+// quoteIndicatorCodec converts a Financial.Quotes.Indicator to and from each of the supported formats
+var quoteIndicatorCodec = utils.NewEnumCodec[Financial_Quotes_Indicator](quoteIndicatorAlternates, quoteIndicatorMapping).
+	WithCSV(utils.FormatNumber[Financial_Quotes_Indicator]).
+	WithSQL(utils.NumberValue[Financial_Quotes_Indicator]).
+	WithDescriptions(QuoteIndicatorDescriptions)
+
+// MarshalJSON converts a Financial.Quotes.Indicator to JSON
+func (enum Financial_Quotes_Indicator) MarshalJSON() ([]byte, error) {
+	return quoteIndicatorCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Quotes.Indicator to a CSV cell value
+func (enum Financial_Quotes_Indicator) MarshalCSV() (string, error) {
+	return quoteIndicatorCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Quotes.Indicator to text
+func (enum Financial_Quotes_Indicator) MarshalText() ([]byte, error) {
+	return quoteIndicatorCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Quotes.Indicator to a YAML node value
+func (enum Financial_Quotes_Indicator) MarshalYAML() (interface{}, error) {
+	return quoteIndicatorCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Quotes.Indicator to an XML element
+func (enum Financial_Quotes_Indicator) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return quoteIndicatorCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Quotes.Indicator to an XML attribute
+func (enum Financial_Quotes_Indicator) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return quoteIndicatorCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Quotes.Indicator to a BSON value
+func (enum Financial_Quotes_Indicator) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return quoteIndicatorCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Quotes.Indicator to a DynamoDB attribute value
+func (enum Financial_Quotes_Indicator) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return quoteIndicatorCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Quotes.Indicator to an SQL value
+func (enum Financial_Quotes_Indicator) Value() (driver.Value, error) {
+	return quoteIndicatorCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalJSON(raw []byte) error {
+	return quoteIndicatorCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalCSV(raw string) error {
+	return quoteIndicatorCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalText(raw []byte) error {
+	return quoteIndicatorCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalYAML(value *yaml.Node) error {
+	return quoteIndicatorCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return quoteIndicatorCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalXMLAttr(attr xml.Attr) error {
+	return quoteIndicatorCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return quoteIndicatorCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return quoteIndicatorCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Quotes.Indicator
+func (enum *Financial_Quotes_Indicator) Scan(value interface{}) error {
+	return quoteIndicatorCodec.DecodeSQL(value, enum)
+}
+
+// tradeConditionCodec converts a Financial.Trades.Condition to and from each of the supported formats
+var tradeConditionCodec = utils.NewEnumCodec[Financial_Trades_Condition](tradeConditionAlternates, tradeConditionMapping).
+	WithCSV(utils.FormatNumber[Financial_Trades_Condition]).
+	WithSQL(utils.NumberValue[Financial_Trades_Condition]).
+	WithDescriptions(TradeConditionDescriptions).
+	WithAllowedCollisions("soldoutofsequence", "opraa", "oprab", "oprac", "oprad", "oprae", "opraf", "oprag", "oprah", "oprai", "opraj", "opras")
+
+// MarshalJSON converts a Financial.Trades.Condition to JSON
+func (enum Financial_Trades_Condition) MarshalJSON() ([]byte, error) {
+	return tradeConditionCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Trades.Condition to a CSV cell value
+func (enum Financial_Trades_Condition) MarshalCSV() (string, error) {
+	return tradeConditionCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Trades.Condition to text
+func (enum Financial_Trades_Condition) MarshalText() ([]byte, error) {
+	return tradeConditionCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Trades.Condition to a YAML node value
+func (enum Financial_Trades_Condition) MarshalYAML() (interface{}, error) {
+	return tradeConditionCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Trades.Condition to an XML element
+func (enum Financial_Trades_Condition) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return tradeConditionCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Trades.Condition to an XML attribute
+func (enum Financial_Trades_Condition) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return tradeConditionCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Trades.Condition to a BSON value
+func (enum Financial_Trades_Condition) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return tradeConditionCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Trades.Condition to a DynamoDB attribute value
+func (enum Financial_Trades_Condition) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return tradeConditionCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Trades.Condition to an SQL value
+func (enum Financial_Trades_Condition) Value() (driver.Value, error) {
+	return tradeConditionCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalJSON(raw []byte) error {
+	return tradeConditionCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalCSV(raw string) error {
+	return tradeConditionCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalText(raw []byte) error {
+	return tradeConditionCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalYAML(value *yaml.Node) error {
+	return tradeConditionCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return tradeConditionCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalXMLAttr(attr xml.Attr) error {
+	return tradeConditionCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return tradeConditionCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return tradeConditionCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Trades.Condition
+func (enum *Financial_Trades_Condition) Scan(value interface{}) error {
+	return tradeConditionCodec.DecodeSQL(value, enum)
+}
+
+// tradeCorrectionCodec converts a Financial.Trades.CorrectionCode to and from each of the supported formats
+var tradeCorrectionCodec = utils.NewEnumCodec[Financial_Trades_CorrectionCode](tradeCorrectionAlternates, tradeCorrectionMapping).
+	WithCSV(func(enum Financial_Trades_CorrectionCode) string { return fmt.Sprintf("%02d", enum) }).
+	WithSQL(utils.NumberValue[Financial_Trades_CorrectionCode]).
+	WithDescriptions(TradeCorrectionDescriptions)
+
+// MarshalJSON converts a Financial.Trades.CorrectionCode to JSON
+func (enum Financial_Trades_CorrectionCode) MarshalJSON() ([]byte, error) {
+	return tradeCorrectionCodec.EncodeJSON(enum)
+}
+
+// MarshalCSV converts a Financial.Trades.CorrectionCode to a CSV cell value
+func (enum Financial_Trades_CorrectionCode) MarshalCSV() (string, error) {
+	return tradeCorrectionCodec.EncodeCSV(enum)
+}
+
+// MarshalText converts a Financial.Trades.CorrectionCode to text
+func (enum Financial_Trades_CorrectionCode) MarshalText() ([]byte, error) {
+	return tradeCorrectionCodec.EncodeText(enum)
+}
+
+// MarshalYAML converts a Financial.Trades.CorrectionCode to a YAML node value
+func (enum Financial_Trades_CorrectionCode) MarshalYAML() (interface{}, error) {
+	return tradeCorrectionCodec.EncodeYAML(enum)
+}
+
+// MarshalXML converts a Financial.Trades.CorrectionCode to an XML element
+func (enum Financial_Trades_CorrectionCode) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return tradeCorrectionCodec.EncodeXML(enum, encoder, start)
+}
+
+// MarshalXMLAttr converts a Financial.Trades.CorrectionCode to an XML attribute
+func (enum Financial_Trades_CorrectionCode) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return tradeCorrectionCodec.EncodeXMLAttr(enum, name)
+}
+
+// MarshalBSONValue converts a Financial.Trades.CorrectionCode to a BSON value
+func (enum Financial_Trades_CorrectionCode) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return tradeCorrectionCodec.EncodeBSON(enum)
+}
+
+// MarshalDynamoDBAttributeValue converts a Financial.Trades.CorrectionCode to a DynamoDB attribute value
+func (enum Financial_Trades_CorrectionCode) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return tradeCorrectionCodec.EncodeDynamoDB(enum)
+}
+
+// Value converts a Financial.Trades.CorrectionCode to an SQL value
+func (enum Financial_Trades_CorrectionCode) Value() (driver.Value, error) {
+	return tradeCorrectionCodec.EncodeSQL(enum)
+}
+
+// UnmarshalJSON converts JSON data into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalJSON(raw []byte) error {
+	return tradeCorrectionCodec.DecodeJSON(raw, enum)
+}
+
+// UnmarshalCSV converts a CSV cell value into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalCSV(raw string) error {
+	return tradeCorrectionCodec.DecodeCSV(raw, enum)
+}
+
+// UnmarshalText converts text into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalText(raw []byte) error {
+	return tradeCorrectionCodec.DecodeText(raw, enum)
+}
+
+// UnmarshalYAML converts a YAML node into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalYAML(value *yaml.Node) error {
+	return tradeCorrectionCodec.DecodeYAML(value, enum)
+}
+
+// UnmarshalXML converts an XML element into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return tradeCorrectionCodec.DecodeXML(decoder, start, enum)
+}
+
+// UnmarshalXMLAttr converts an XML attribute into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalXMLAttr(attr xml.Attr) error {
+	return tradeCorrectionCodec.DecodeXMLAttr(attr, enum)
+}
+
+// UnmarshalBSONValue converts a BSON value into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalBSONValue(typ bsontype.Type, raw []byte) error {
+	return tradeCorrectionCodec.DecodeBSON(typ, raw, enum)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB attribute value into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	return tradeCorrectionCodec.DecodeDynamoDB(value, enum)
+}
+
+// Scan converts an SQL value into a Financial.Trades.CorrectionCode
+func (enum *Financial_Trades_CorrectionCode) Scan(value interface{}) error {
+	return tradeCorrectionCodec.DecodeSQL(value, enum)
+}
+
+// Validate each codec when the package is initialized so that names which collide after normalization are
+// found when the program starts, rather than when one of them is first decoded
+func init() {
+	for _, codec := range []interface{ Validate() error }{
+		providerCodec,
+		assetClassCodec,
+		assetTypeCodec,
+		localeCodec,
+		tapeCodec,
+		dividendFrequencyCodec,
+		dividendTypeCodec,
+		exchangeTypeCodec,
+		optionContractTypeCodec,
+		optionExerciseStyleCodec,
+		optionUnderlyingTypeCodec,
+		quoteConditionCodec,
+		quoteIndicatorCodec,
+		tradeConditionCodec,
+		tradeCorrectionCodec,
+	} {
+		if err := codec.Validate(); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -1,8 +1,15 @@
 package gopb
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"reflect"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
@@ -10,6 +17,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/xefino/protobuf-gen-go/utils"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"gopkg.in/yaml.v3"
 )
 
@@ -77,7 +87,8 @@ var _ = Describe("Decimal Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("can't convert derp to decimal: exponent is not numeric"))
+		Expect(err.Error()).Should(Equal("value of \"derp\" could not be parsed as a Decimal: " +
+			"can't convert derp to decimal: exponent is not numeric"))
 	})
 
 	// Test the conditions under which values should be convertible to a Decimal
@@ -108,7 +119,8 @@ var _ = Describe("Decimal Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("can't convert derp to decimal: exponent is not numeric"))
+		Expect(err.Error()).Should(Equal("value of \"derp\" could not be parsed as a Decimal: " +
+			"can't convert derp to decimal: exponent is not numeric"))
 	})
 
 	// Test the conditions under which values should be convertible to a Decimal
@@ -143,7 +155,8 @@ var _ = Describe("Decimal Marshal/Unmarshal Tests", func() {
 		value := new(Decimal)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberS{Value: "derp"}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("can't convert derp to decimal: exponent is not numeric"))
+		Expect(err.Error()).Should(Equal("value of \"derp\" could not be parsed as a Decimal: " +
+			"can't convert derp to decimal: exponent is not numeric"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -190,7 +203,7 @@ var _ = Describe("Decimal Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("failed to convert driver value of type bool to Decimal"))
+		Expect(err.Error()).Should(Equal("Driver value of bool could not be converted to a Decimal"))
 	})
 
 	// Tests that, if the value is invalid, then Scan will return an error
@@ -202,7 +215,8 @@ var _ = Describe("Decimal Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("can't convert derp to decimal: exponent is not numeric"))
+		Expect(err.Error()).Should(Equal("value of \"derp\" could not be parsed as a Decimal: " +
+			"can't convert derp to decimal: exponent is not numeric"))
 	})
 
 	// Tests the conditions under which Scan is called and no error is generated
@@ -393,7 +407,7 @@ var _ = Describe("Provider Marshal/Unmarshal Tests", func() {
 		enum := new(Provider)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &enum)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Provider"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Provider"))
 	})
 
 	// Tests that, if time parsing fails, then calling UnmarshalDynamoDBAttributeValue will return an error
@@ -510,17 +524,17 @@ var _ = Describe("UnixTimestamp Marshal/Unmarshal Tests", func() {
 			Expect(err.Error()).Should(Equal(message))
 		},
 		Entry("String is too short - Error", "derp", true,
-			"value (derp) was not long enough to be converted to a timestamp"),
+			"value of \"derp\" could not be parsed as a UnixTimestamp at position 4: input is too short"),
 		Entry("Seconds cannot be converted to an integer - Error", "derp983651350", true,
-			"failed to convert seconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"derp\": invalid syntax"),
+			"value of \"derp983651350\" could not be parsed as a UnixTimestamp at position 0: "+
+				"strconv.ParseInt: parsing \"derp\": invalid syntax"),
 		Entry("Nanoseconds cannot be converted to an integer - Error", "165412799398365135j", true,
-			"failed to convert nanoseconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"98365135j\": invalid syntax"),
+			"value of \"165412799398365135j\" could not be parsed as a UnixTimestamp at position 10: "+
+				"strconv.ParseInt: parsing \"98365135j\": invalid syntax"),
 		Entry("Seconds < Minimum Timestamp - Error", "-62135596801983651350", false,
-			"timestamp (-62135596801, 983651350) before 0001-01-01"),
+			"timestamp (-62135596801, 983651350) is less than the minimum of 0001-01-01"),
 		Entry("Seconds > Maximum Timestamp - Error", "253402300800983651350", false,
-			"timestamp (253402300800, 983651350) after 9999-12-31"))
+			"timestamp (253402300800, 983651350) is greater than the maximum of 9999-12-31"))
 
 	// Test that, if UnmarshalJSON is called with a value of nil then the timestamp will be nil
 	It("UnmarshalJSON - Nil - Nil", func() {
@@ -594,17 +608,17 @@ var _ = Describe("UnixTimestamp Marshal/Unmarshal Tests", func() {
 			Expect(err.Error()).Should(Equal(message))
 		},
 		Entry("String is too short - Error", "derp",
-			"value (derp) was not long enough to be converted to a timestamp"),
+			"value of \"derp\" could not be parsed as a UnixTimestamp at position 4: input is too short"),
 		Entry("Seconds cannot be converted to an integer - Error", "derp983651350",
-			"failed to convert seconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"derp\": invalid syntax"),
+			"value of \"derp983651350\" could not be parsed as a UnixTimestamp at position 0: "+
+				"strconv.ParseInt: parsing \"derp\": invalid syntax"),
 		Entry("Nanoseconds cannot be converted to an integer - Error", "165412799398365135j",
-			"failed to convert nanoseconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"98365135j\": invalid syntax"),
+			"value of \"165412799398365135j\" could not be parsed as a UnixTimestamp at position 10: "+
+				"strconv.ParseInt: parsing \"98365135j\": invalid syntax"),
 		Entry("Seconds < Minimum Timestamp - Error", "-62135596801983651350",
-			"timestamp (-62135596801, 983651350) before 0001-01-01"),
+			"timestamp (-62135596801, 983651350) is less than the minimum of 0001-01-01"),
 		Entry("Seconds > Maximum Timestamp - Error", "253402300800983651350",
-			"timestamp (253402300800, 983651350) after 9999-12-31"))
+			"timestamp (253402300800, 983651350) is greater than the maximum of 9999-12-31"))
 
 	// Test that, if UnmarshalCSV is called with an empty string then the timestamp will be nil
 	It("UnmarshalCSV - Empty string - Nil", func() {
@@ -684,20 +698,21 @@ var _ = Describe("UnixTimestamp Marshal/Unmarshal Tests", func() {
 			Expect(err.Error()).Should(Equal(message))
 		},
 		Entry("Type is invalid - Error", true,
-			"Value of true with a type of bool could not be converted to a UnixTimestamp"),
+			"Driver value of bool could not be converted to a UnixTimestamp"),
 		Entry("String is too short - Error", "derp",
-			"value (derp) was not long enough to be converted to a timestamp"),
+			"value of \"derp\" could not be parsed as a UnixTimestamp at position 4: input is too short"),
 		Entry("Seconds cannot be converted to an integer - Error", "derp983651350",
-			"failed to convert seconds part to integer, error: strconv.ParseInt: parsing \"derp\": invalid syntax"),
+			"value of \"derp983651350\" could not be parsed as a UnixTimestamp at position 0: "+
+				"strconv.ParseInt: parsing \"derp\": invalid syntax"),
 		Entry("Nanoseconds cannot be converted to an integer - Error", "165412799398365135j",
-			"failed to convert nanoseconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"98365135j\": invalid syntax"),
+			"value of \"165412799398365135j\" could not be parsed as a UnixTimestamp at position 10: "+
+				"strconv.ParseInt: parsing \"98365135j\": invalid syntax"),
 		Entry("Seconds < Minimum Timestamp - Error", "-62135596801983651350",
-			"timestamp (-62135596801, 983651350) before 0001-01-01"),
+			"timestamp (-62135596801, 983651350) is less than the minimum of 0001-01-01"),
 		Entry("Seconds > Maximum Timestamp - Error", "253402300800983651350",
-			"timestamp (253402300800, 983651350) after 9999-12-31"),
+			"timestamp (253402300800, 983651350) is greater than the maximum of 9999-12-31"),
 		Entry("Nanoseconds > 1 second - Error", "1654127993-10000000",
-			"timestamp (1654127993, -10000000) has out-of-range nanos"))
+			"timestamp nanos (1654127993, -10000000) is less than the minimum of 0"))
 
 	// Test that, if Scan is called with a value of nil then the timestamp will be nil
 	It("Scan - Nil - Nil", func() {
@@ -833,17 +848,17 @@ var _ = Describe("UnixDuration Marshal/Unmarshal Tests", func() {
 			Expect(err.Error()).Should(Equal(message))
 		},
 		Entry("String is too short - Error", "derp", true,
-			"value (derp) was not long enough to be converted to a duration"),
+			"value of \"derp\" could not be parsed as a UnixDuration at position 4: input is too short"),
 		Entry("Seconds cannot be converted to an integer - Error", "derp983651350", true,
-			"failed to convert seconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"derp\": invalid syntax"),
+			"value of \"derp983651350\" could not be parsed as a UnixDuration at position 0: "+
+				"strconv.ParseInt: parsing \"derp\": invalid syntax"),
 		Entry("Nanoseconds cannot be converted to an integer - Error", "165412799398365135j", true,
-			"failed to convert nanoseconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"98365135j\": invalid syntax"),
+			"value of \"165412799398365135j\" could not be parsed as a UnixDuration at position 10: "+
+				"strconv.ParseInt: parsing \"98365135j\": invalid syntax"),
 		Entry("Seconds < Minimum Duration - Error", "-315576000001983651350", false,
-			"duration (-315576000001, -983651350) exceeds -10000 years"),
+			"duration (-315576000001, -983651350) is less than the minimum of -10000 years"),
 		Entry("Seconds > Maximum Duration - Error", "315576000001983651350", false,
-			"duration (315576000001, 983651350) exceeds +10000 years"))
+			"duration (315576000001, 983651350) is greater than the maximum of +10000 years"))
 
 	// Test that, if UnmarshalJSON is called with a value of nil then the duration will be nil
 	It("UnmarshalJSON - Nil - Nil", func() {
@@ -929,17 +944,17 @@ var _ = Describe("UnixDuration Marshal/Unmarshal Tests", func() {
 			Expect(err.Error()).Should(Equal(message))
 		},
 		Entry("String is too short - Error", "derp",
-			"value (derp) was not long enough to be converted to a duration"),
+			"value of \"derp\" could not be parsed as a UnixDuration at position 4: input is too short"),
 		Entry("Seconds cannot be converted to an integer - Error", "derp983651350",
-			"failed to convert seconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"derp\": invalid syntax"),
+			"value of \"derp983651350\" could not be parsed as a UnixDuration at position 0: "+
+				"strconv.ParseInt: parsing \"derp\": invalid syntax"),
 		Entry("Nanoseconds cannot be converted to an integer - Error", "165412799398365135j",
-			"failed to convert nanoseconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"98365135j\": invalid syntax"),
+			"value of \"165412799398365135j\" could not be parsed as a UnixDuration at position 10: "+
+				"strconv.ParseInt: parsing \"98365135j\": invalid syntax"),
 		Entry("Seconds < Minimum Duration - Error", "-315576000001983651350",
-			"duration (-315576000001, -983651350) exceeds -10000 years"),
+			"duration (-315576000001, -983651350) is less than the minimum of -10000 years"),
 		Entry("Seconds > Maximum Duration - Error", "315576000001983651350",
-			"duration (315576000001, 983651350) exceeds +10000 years"))
+			"duration (315576000001, 983651350) is greater than the maximum of +10000 years"))
 
 	// Test that, if UnmarshalCSV is called with an empty string then the duration will be nil
 	It("UnmarshalCSV - Empty string - Nil", func() {
@@ -1032,16 +1047,17 @@ var _ = Describe("UnixDuration Marshal/Unmarshal Tests", func() {
 			Expect(err.Error()).Should(Equal(message))
 		},
 		Entry("String is too short - Error", "derp",
-			"value (derp) was not long enough to be converted to a duration"),
+			"value of \"derp\" could not be parsed as a UnixDuration at position 4: input is too short"),
 		Entry("Seconds cannot be converted to an integer - Error", "derp983651350",
-			"failed to convert seconds part to integer, error: strconv.ParseInt: parsing \"derp\": invalid syntax"),
+			"value of \"derp983651350\" could not be parsed as a UnixDuration at position 0: "+
+				"strconv.ParseInt: parsing \"derp\": invalid syntax"),
 		Entry("Nanoseconds cannot be converted to an integer - Error", "165412799398365135j",
-			"failed to convert nanoseconds part to integer, error: strconv.ParseInt: "+
-				"parsing \"98365135j\": invalid syntax"),
+			"value of \"165412799398365135j\" could not be parsed as a UnixDuration at position 10: "+
+				"strconv.ParseInt: parsing \"98365135j\": invalid syntax"),
 		Entry("Seconds < Minimum Duration - Error", "-315576000001983651350",
-			"duration (-315576000001, -983651350) exceeds -10000 years"),
+			"duration (-315576000001, -983651350) is less than the minimum of -10000 years"),
 		Entry("Seconds > Maximum Duration - Error", "315576000001983651350",
-			"duration (315576000001, 983651350) exceeds +10000 years"),
+			"duration (315576000001, 983651350) is greater than the maximum of +10000 years"),
 		Entry("Nanoseconds > 1 second - Error", "1654127993-10000000",
 			"duration (1654127993, -10000000) has seconds and nanos with different signs"))
 
@@ -1270,7 +1286,7 @@ var _ = Describe("Financial.Common.AssetClass Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Common_AssetClass)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Common.AssetClass"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Common_AssetClass"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -1376,7 +1392,7 @@ var _ = Describe("Financial.Common.AssetClass Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Common_AssetClass"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -1802,7 +1818,7 @@ var _ = Describe("Financial.Common.AssetType Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Common_AssetType)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Common.AssetType"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Common_AssetType"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -2148,7 +2164,7 @@ var _ = Describe("Financial.Common.AssetType Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Common_AssetType"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -2391,7 +2407,7 @@ var _ = Describe("Financial.Common.Locale Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Common_Locale)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Common.Locale"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Common_Locale"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -2433,7 +2449,7 @@ var _ = Describe("Financial.Common.Locale Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Common_Locale"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -2599,7 +2615,7 @@ var _ = Describe("Financial.Common.Tape Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Common_Tape)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Common.Tape"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Common_Tape"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -2641,7 +2657,7 @@ var _ = Describe("Financial.Common.Tape Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Common_Tape"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -2822,7 +2838,7 @@ var _ = Describe("Financial.Dividends.Frequency Marshal/Unmarshal Tests", func()
 		value := new(Financial_Dividends_Frequency)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Dividends.Frequency"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Dividends_Frequency"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -2890,7 +2906,7 @@ var _ = Describe("Financial.Dividends.Frequency Marshal/Unmarshal Tests", func()
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Dividends_Frequency"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -3075,7 +3091,7 @@ var _ = Describe("Financial.Dividends.Type Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Dividends_Type)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Dividends.Type"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Dividends_Type"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -3129,7 +3145,7 @@ var _ = Describe("Financial.Dividends.Type Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Dividends_Type"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -3285,7 +3301,7 @@ var _ = Describe("Financial.Exchanges.Type Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Exchanges_Type)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Exchanges.Type"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Exchanges_Type"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -3331,7 +3347,7 @@ var _ = Describe("Financial.Exchanges.Type Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Exchanges_Type"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -3499,7 +3515,7 @@ var _ = Describe("Financial.Options.ContractType Marshal/Unmarshal Tests", func(
 		value := new(Financial_Options_ContractType)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Options.ContractType"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Options_ContractType"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -3553,7 +3569,7 @@ var _ = Describe("Financial.Options.ContractType Marshal/Unmarshal Tests", func(
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Options_ContractType"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -3723,7 +3739,7 @@ var _ = Describe("Financial.Options.ExerciseStyle Marshal/Unmarshal Tests", func
 		value := new(Financial_Options_ExerciseStyle)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Options.ExerciseStyle"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Options_ExerciseStyle"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -3777,7 +3793,7 @@ var _ = Describe("Financial.Options.ExerciseStyle Marshal/Unmarshal Tests", func
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Options_ExerciseStyle"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -3937,7 +3953,7 @@ var _ = Describe("Financial.Options.UnderlyingType Marshal/Unmarshal Tests", fun
 		value := new(Financial_Options_UnderlyingType)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Options.UnderlyingType"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Options_UnderlyingType"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -3981,7 +3997,7 @@ var _ = Describe("Financial.Options.UnderlyingType Marshal/Unmarshal Tests", fun
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Options_UnderlyingType"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -4864,7 +4880,7 @@ var _ = Describe("Financial.Quotes.Condition Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Quotes_Condition)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Quotes.Condition"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Quotes_Condition"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -5456,7 +5472,7 @@ var _ = Describe("Financial.Quotes.Condition Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Quotes_Condition"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -7345,7 +7361,7 @@ var _ = Describe("Financial.Quotes.Indicator Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Quotes_Indicator)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Quotes.Indicator"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Quotes_Indicator"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -8744,7 +8760,7 @@ var _ = Describe("Financial.Quotes.Indicator Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Quotes_Indicator"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -10836,7 +10852,7 @@ var _ = Describe("Financial.Trades.Condition Marshal/Unmarshal Tests", func() {
 		value := new(Financial_Trades_Condition)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Trades.Condition"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Trades_Condition"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -11951,7 +11967,7 @@ var _ = Describe("Financial.Trades.Condition Marshal/Unmarshal Tests", func() {
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Trades_Condition"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -12639,7 +12655,7 @@ var _ = Describe("Financial.Trades.CorrectionCode Marshal/Unmarshal Tests", func
 		value := new(Financial_Trades_CorrectionCode)
 		err := attributevalue.Unmarshal(&types.AttributeValueMemberBOOL{Value: true}, &value)
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a Financial.Trades.CorrectionCode"))
+		Expect(err.Error()).Should(Equal("Attribute value of *types.AttributeValueMemberBOOL could not be converted to a gopb.Financial_Trades_CorrectionCode"))
 	})
 
 	// Tests the conditions under which UnmarshalDynamoDBAttributeValue is called and no error is generated
@@ -12729,7 +12745,7 @@ var _ = Describe("Financial.Trades.CorrectionCode Marshal/Unmarshal Tests", func
 
 		// Verify the error
 		Expect(err).Should(HaveOccurred())
-		Expect(err.Error()).Should(Equal("value of %!q(<nil>) had an invalid type of <nil>"))
+		Expect(err.Error()).Should(Equal("Driver value of <nil> could not be converted to a gopb.Financial_Trades_CorrectionCode"))
 		Expect(enum).Should(BeNil())
 	})
 
@@ -12771,3 +12787,552 @@ var _ = Describe("Financial.Trades.CorrectionCode Marshal/Unmarshal Tests", func
 		Entry("11 - Works", 11, Financial_Trades_ErrorRecord),
 		Entry("12 - Works", 12, Financial_Trades_CorrectionRecord))
 })
+
+var _ = Describe("Enum Method Set Tests", func() {
+
+	// Interfaces that should be implemented by the value of every enum
+	type marshaller interface {
+		json.Marshaler
+		encoding.TextMarshaler
+		yaml.Marshaler
+		xml.Marshaler
+		xml.MarshalerAttr
+		bsoncodec.ValueMarshaler
+		attributevalue.Marshaler
+		driver.Valuer
+		MarshalCSV() (string, error)
+	}
+
+	// Interfaces that should be implemented by a pointer to every enum
+	type unmarshaller interface {
+		json.Unmarshaler
+		encoding.TextUnmarshaler
+		yaml.Unmarshaler
+		xml.Unmarshaler
+		xml.UnmarshalerAttr
+		bsoncodec.ValueUnmarshaler
+		attributevalue.Unmarshaler
+		sql.Scanner
+		UnmarshalCSV(string) error
+	}
+
+	// Helper function that collects the enums declared in a list of messages, including nested messages
+	var collectEnums func(enums protoreflect.EnumDescriptors, messages protoreflect.MessageDescriptors) []protoreflect.EnumDescriptor
+	collectEnums = func(enums protoreflect.EnumDescriptors, messages protoreflect.MessageDescriptors) []protoreflect.EnumDescriptor {
+		var collected []protoreflect.EnumDescriptor
+		for i := 0; i < enums.Len(); i++ {
+			collected = append(collected, enums.Get(i))
+		}
+
+		for i := 0; i < messages.Len(); i++ {
+			collected = append(collected, collectEnums(messages.Get(i).Enums(), messages.Get(i).Messages())...)
+		}
+
+		return collected
+	}
+
+	// Tests that every enum declared in the proto files has the complete set of marshalling and
+	// unmarshalling methods, and that converting each value to SQL and back again works
+	It("All enums - Complete method set", func() {
+		files := []protoreflect.FileDescriptor{
+			File_protos_common_common_proto,
+			File_protos_common_decimal_proto,
+			File_protos_common_financial_proto,
+			File_protos_common_time_proto,
+		}
+
+		var count int
+		for _, file := range files {
+			for _, desc := range collectEnums(file.Enums(), file.Messages()) {
+				enumType, err := protoregistry.GlobalTypes.FindEnumByName(desc.FullName())
+				Expect(err).ShouldNot(HaveOccurred())
+				count++
+
+				// Verify that the enum value and a pointer to it implement the required interfaces
+				for i := 0; i < desc.Values().Len(); i++ {
+					value := enumType.New(desc.Values().Get(i).Number())
+					marshaller, ok := value.(marshaller)
+					Expect(ok).Should(BeTrue(), "%s does not implement all marshalling methods", desc.FullName())
+
+					ptr := reflect.New(reflect.TypeOf(value))
+					unmarshaller, ok := ptr.Interface().(unmarshaller)
+					Expect(ok).Should(BeTrue(), "*%s does not implement all unmarshalling methods", desc.FullName())
+
+					// Verify that the value can be converted to SQL and back again
+					sqlValue, err := marshaller.Value()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(unmarshaller.Scan(sqlValue)).ShouldNot(HaveOccurred(), "%s: %v", desc.FullName(), sqlValue)
+
+					// Invalid quote conditions are written to SQL as -1, which is not a value of the enum, so
+					// they are read back as -1 rather than as Invalid
+					var expected interface{} = value
+					if value == protoreflect.Enum(Financial_Quotes_Invalid) {
+						expected = Financial_Quotes_Condition(-1)
+					}
+
+					Expect(ptr.Elem().Interface()).Should(Equal(expected), "%s: %v", desc.FullName(), sqlValue)
+				}
+			}
+		}
+
+		Expect(count).Should(Equal(15))
+	})
+})
+
+var _ = Describe("Normalized Enum Parsing Tests", func() {
+
+	// Tests that names which differ from a name or alternate only in case, separators or punctuation
+	// are converted to the correct value
+	DescribeTable("UnmarshalCSV - Normalized names - Works",
+		func(raw string, expected Financial_Common_AssetType) {
+			var enum Financial_Common_AssetType
+			Expect(enum.UnmarshalCSV(raw)).ShouldNot(HaveOccurred())
+			Expect(enum).Should(Equal(expected))
+		},
+		Entry("Upper case - Works", "COMMONSHARE", Financial_Common_CommonShare),
+		Entry("Kebab case - Works", "Common-Share", Financial_Common_CommonShare),
+		Entry("Snake case - Works", "common_share", Financial_Common_CommonShare),
+		Entry("Camel case - Works", "commonShare", Financial_Common_CommonShare),
+		Entry("Spaces - Works", "  common share ", Financial_Common_CommonShare),
+		Entry("Alternate, upper case - Works", "PREFERRED ADR", Financial_Common_AmericanDepositoryReceiptPreferred))
+
+	// Tests that normalized names are also converted for other formats
+	It("UnmarshalJSON, Scan, UnmarshalDynamoDBAttributeValue - Normalized names - Works", func() {
+		var provider Provider
+		Expect(json.Unmarshal([]byte("\"POLYGON\""), &provider)).ShouldNot(HaveOccurred())
+		Expect(provider).Should(Equal(Provider_Polygon))
+
+		var class Financial_Common_AssetClass
+		Expect(class.Scan("STOCKS")).ShouldNot(HaveOccurred())
+		Expect(class).Should(Equal(Financial_Common_Stock))
+
+		var style Financial_Options_ExerciseStyle
+		Expect(style.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberS{Value: "EUROPEAN"})).
+			ShouldNot(HaveOccurred())
+		Expect(style).Should(Equal(Financial_Options_European))
+	})
+
+	// Tests that names which normalize to a form shared by names referring to different values are
+	// still converted when they match exactly, but that an error is returned otherwise
+	It("UnmarshalCSV - Ambiguous names - Fails", func() {
+		var cond Financial_Trades_Condition
+		Expect(cond.UnmarshalCSV("OPRA:a")).ShouldNot(HaveOccurred())
+		Expect(cond).Should(Equal(Financial_Trades_SingleLegAuctionNonISO))
+
+		err := cond.UnmarshalCSV("opra:A ")
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("value of \"opra:A \" is ambiguous for a gopb.Financial_Trades_Condition " +
+			"as it could refer to any of \"OPRA:A\", \"OPRA:a\""))
+	})
+
+	// Tests that collisions between normalized names are detected so they can be reported; only the
+	// case-sensitive OPRA codes and one trade condition alternate are expected to collide
+	It("Collisions - Works", func() {
+		Expect(providerCodec.Collisions()).Should(BeEmpty())
+		Expect(assetClassCodec.Collisions()).Should(BeEmpty())
+		Expect(assetTypeCodec.Collisions()).Should(BeEmpty())
+		Expect(localeCodec.Collisions()).Should(BeEmpty())
+		Expect(tapeCodec.Collisions()).Should(BeEmpty())
+		Expect(dividendFrequencyCodec.Collisions()).Should(BeEmpty())
+		Expect(dividendTypeCodec.Collisions()).Should(BeEmpty())
+		Expect(exchangeTypeCodec.Collisions()).Should(BeEmpty())
+		Expect(optionContractTypeCodec.Collisions()).Should(BeEmpty())
+		Expect(optionExerciseStyleCodec.Collisions()).Should(BeEmpty())
+		Expect(optionUnderlyingTypeCodec.Collisions()).Should(BeEmpty())
+		Expect(quoteConditionCodec.Collisions()).Should(BeEmpty())
+		Expect(quoteIndicatorCodec.Collisions()).Should(BeEmpty())
+		Expect(tradeCorrectionCodec.Collisions()).Should(BeEmpty())
+
+		collisions := tradeConditionCodec.Collisions()
+		Expect(collisions).Should(HaveLen(12))
+		Expect(collisions[11].Error()).Should(Equal("names \"SoldOutOfSequence\", \"Sold (Out of Sequence)\", \"Sold Out of Sequence\" of " +
+			"gopb.Financial_Trades_Condition all normalize to \"soldoutofsequence\""))
+	})
+
+	// Tests that the Validate function allows the collisions listed in the alias file, which is required for
+	// the package to initialize, and returns an error for any collisions that were not allowed
+	It("Validate - Works", func() {
+		Expect(tradeConditionCodec.Validate()).ShouldNot(HaveOccurred())
+
+		codec := utils.NewEnumCodec[Financial_Common_AssetClass](map[string]Financial_Common_AssetClass{
+			"STOCK":            Financial_Common_OverTheCounter,
+			"Over the Counter": Financial_Common_Stock,
+			"crypto":           Financial_Common_Crypto,
+		}, nil)
+
+		err := codec.Validate()
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal("enum Financial.Common.AssetClass has names that collide after normalization: " +
+			"names \"OverTheCounter\", \"Over the Counter\" of gopb.Financial_Common_AssetClass all normalize to " +
+			"\"overthecounter\"; " +
+			"names \"Stock\", \"STOCK\" of gopb.Financial_Common_AssetClass all normalize to \"stock\""))
+
+		codec = utils.NewEnumCodec[Financial_Common_AssetClass](map[string]Financial_Common_AssetClass{
+			"STOCK": Financial_Common_OverTheCounter,
+		}, nil).WithAllowedCollisions("stock")
+		Expect(codec.Validate()).ShouldNot(HaveOccurred())
+	})
+})
+
+var _ = Describe("Strict Decoding Tests", func() {
+
+	// Ensure that the global decoding mode is restored after each test
+	AfterEach(func() {
+		utils.SetDecodingMode(utils.DefaultDecoding)
+	})
+
+	// Tests that, by default, integers that aren't defined for an enum are accepted
+	It("UnmarshalCSV, Scan - Lenient, undefined integer - Works", func() {
+		var tape Financial_Common_Tape
+		Expect(tape.UnmarshalCSV("57")).ShouldNot(HaveOccurred())
+		Expect(tape).Should(Equal(Financial_Common_Tape(57)))
+
+		Expect(tape.Scan(int64(58))).ShouldNot(HaveOccurred())
+		Expect(tape).Should(Equal(Financial_Common_Tape(58)))
+	})
+
+	// Tests that integers that don't fit in an enum are rejected, even when decoding leniently
+	It("Scan - Lenient, integer out of range - Fails", func() {
+		tape := Financial_Common_B
+		err := tape.Scan(int64(4294967296))
+		Expect(err).Should(HaveOccurred())
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(err.Error()).Should(Equal("value of 4294967296 is not a valid gopb.Financial_Common_Tape"))
+		Expect(tape).Should(Equal(Financial_Common_B))
+	})
+
+	// Tests that, when decoding strictly, integers that aren't defined for an enum are rejected by
+	// each of the unmarshallers
+	It("Unmarshal - Strict, undefined integer - Fails", func() {
+		utils.SetDecodingMode(utils.StrictDecoding)
+
+		var tape Financial_Common_Tape
+		errs := []error{
+			tape.UnmarshalCSV("57"),
+			json.Unmarshal([]byte("57"), &tape),
+			yaml.Unmarshal([]byte("57"), &tape),
+			tape.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberN{Value: "57"}),
+			tape.Scan(int64(57)),
+		}
+
+		for _, err := range errs {
+			Expect(err).Should(HaveOccurred())
+			Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+
+			var enumErr *utils.EnumError
+			Expect(errors.As(err, &enumErr)).Should(BeTrue())
+			Expect(enumErr.Enum).Should(Equal("gopb.Financial_Common_Tape"))
+			Expect(enumErr.Input).Should(Equal("57"))
+		}
+
+		Expect(tape).Should(Equal(Financial_Common_A))
+	})
+
+	// Tests that, when decoding strictly, integers that are defined for an enum, and strings which are
+	// alternate values, are still accepted
+	It("Unmarshal - Strict, defined integer - Works", func() {
+		utils.SetDecodingMode(utils.StrictDecoding)
+
+		var tape Financial_Common_Tape
+		Expect(tape.UnmarshalCSV("2")).ShouldNot(HaveOccurred())
+		Expect(tape).Should(Equal(Financial_Common_C))
+
+		var cond Financial_Quotes_Condition
+		Expect(cond.Scan(int64(999))).ShouldNot(HaveOccurred())
+		Expect(cond).Should(Equal(Financial_Quotes_Invalid))
+
+		cond = Financial_Quotes_Regular
+		Expect(cond.UnmarshalCSV("-1")).ShouldNot(HaveOccurred())
+		Expect(cond).Should(Equal(Financial_Quotes_Invalid))
+
+		// Integers are not looked up in the alternates so this is not a defined value
+		err := cond.Scan(int64(-1))
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+		Expect(cond).Should(Equal(Financial_Quotes_Invalid))
+	})
+
+	// Tests that names which can't be mapped to an enum value are rejected with a typed error
+	It("UnmarshalCSV - Unknown name - Fails", func() {
+		var tape Financial_Common_Tape
+		err := tape.UnmarshalCSV("derp")
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeFalse())
+	})
+
+	// Tests that the decoding mode of a codec overrides the global decoding mode
+	It("WithDecodingMode - Overrides global mode - Works", func() {
+		strict := utils.NewEnumCodec[Financial_Common_Tape](nil, nil).WithDecodingMode(utils.StrictDecoding)
+		lenient := utils.NewEnumCodec[Financial_Common_Tape](nil, nil).WithDecodingMode(utils.LenientDecoding)
+
+		var tape Financial_Common_Tape
+		Expect(errors.Is(strict.DecodeCSV("57", &tape), utils.ErrUnknownEnumValue)).Should(BeTrue())
+
+		utils.SetDecodingMode(utils.StrictDecoding)
+		Expect(lenient.DecodeCSV("57", &tape)).ShouldNot(HaveOccurred())
+		Expect(tape).Should(Equal(Financial_Common_Tape(57)))
+	})
+
+	// Tests that provider-specific decoding also respects the global decoding mode
+	It("DecodeEnum - Strict, undefined integer - Fails", func() {
+		_, err := DecodeEnum[Financial_Common_Tape](Provider_Polygon, "57")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		utils.SetDecodingMode(utils.StrictDecoding)
+		_, err = DecodeEnum[Financial_Common_Tape](Provider_Polygon, "57")
+		Expect(errors.Is(err, utils.ErrUnknownEnumValue)).Should(BeTrue())
+	})
+})
+
+var _ = Describe("Structured Error Tests", func() {
+
+	// Tests that parse failures return a ParseError that can be detected with errors.Is and errors.As
+	DescribeTable("FromString - Parse failures - ParseError",
+		func(parse func(string) error, raw string, typ string, position int, cause error) {
+			err := parse(raw)
+			Expect(errors.Is(err, utils.ErrMalformed)).Should(BeTrue())
+
+			var parseErr *utils.ParseError
+			Expect(errors.As(err, &parseErr)).Should(BeTrue())
+			Expect(parseErr.Type).Should(Equal(typ))
+			Expect(parseErr.Input).Should(Equal(raw))
+			Expect(parseErr.Position).Should(Equal(position))
+			if cause != nil {
+				Expect(errors.Is(err, cause)).Should(BeTrue())
+			}
+		},
+		Entry("Decimal - Malformed", new(Decimal).FromString, "derp", "Decimal", -1, nil),
+		Entry("UnixTimestamp - Too short", new(UnixTimestamp).FromString, "derp", "UnixTimestamp", 4, utils.ErrTooShort),
+		Entry("UnixTimestamp - Seconds malformed", new(UnixTimestamp).FromString,
+			"derp983651350", "UnixTimestamp", 0, strconv.ErrSyntax),
+		Entry("UnixTimestamp - Nanoseconds malformed", new(UnixTimestamp).FromString,
+			"165412799398365135j", "UnixTimestamp", 10, strconv.ErrSyntax),
+		Entry("UnixDuration - Too short", new(UnixDuration).FromString, "derp", "UnixDuration", 4, utils.ErrTooShort),
+		Entry("UnixDuration - Seconds out of range", new(UnixDuration).FromString,
+			"99999999999999999999983651350", "UnixDuration", 0, strconv.ErrRange))
+
+	// Tests that validation failures return a RangeError that distinguishes an underflow from an overflow
+	DescribeTable("CheckValid, AsDuration - Out of range - RangeError",
+		func(check func() error, sentinel error, bound string) {
+			err := check()
+			Expect(errors.Is(err, sentinel)).Should(BeTrue())
+			Expect(errors.Is(err, utils.ErrMalformed)).Should(BeFalse())
+
+			var rangeErr *utils.RangeError
+			Expect(errors.As(err, &rangeErr)).Should(BeTrue())
+			Expect(rangeErr.Bound).Should(Equal(bound))
+		},
+		Entry("UnixTimestamp - Underflow", NewUnixTimestamp(-62135596801, 0).CheckValid,
+			utils.ErrUnderflow, "0001-01-01"),
+		Entry("UnixTimestamp - Overflow", NewUnixTimestamp(253402300800, 0).CheckValid,
+			utils.ErrOverflow, "9999-12-31"),
+		Entry("UnixDuration - Underflow", NewUnixDuration(-315576000001, 0).CheckValid,
+			utils.ErrUnderflow, "-10000 years"),
+		Entry("AsDuration - Overflow", func() error {
+			_, err := NewUnixDuration(9223372036, 1000000000).AsDuration()
+			return err
+		}, utils.ErrOverflow, "2562047h47m16.854775807s"))
+
+	// Tests that a duration whose seconds and nanoseconds have different signs returns a SignError
+	DescribeTable("CheckValid - Different signs - SignError",
+		func(duration *UnixDuration, value string) {
+			err := duration.CheckValid()
+			Expect(errors.Is(err, utils.ErrSignMismatch)).Should(BeTrue())
+			Expect(errors.Is(err, utils.ErrUnderflow)).Should(BeFalse())
+			Expect(errors.Is(err, utils.ErrOverflow)).Should(BeFalse())
+
+			var signErr *utils.SignError
+			Expect(errors.As(err, &signErr)).Should(BeTrue())
+			Expect(signErr.Value).Should(Equal(value))
+		},
+		Entry("Negative nanoseconds", NewUnixDuration(2678400, -1000), "2678400, -1000"),
+		Entry("Negative seconds", NewUnixDuration(-2678400, 1000), "-2678400, 1000"))
+
+	// Tests that a nil timestamp or duration returns an error wrapping ErrNil
+	It("CheckValid - Nil - ErrNil", func() {
+		var timestamp *UnixTimestamp
+		var duration *UnixDuration
+		Expect(errors.Is(timestamp.CheckValid(), utils.ErrNil)).Should(BeTrue())
+		Expect(errors.Is(duration.CheckValid(), utils.ErrNil)).Should(BeTrue())
+	})
+
+	// Tests that values with unsupported types return a TypeError wrapping ErrUnsupportedType
+	It("UnmarshalDynamoDBAttributeValue, Scan - Invalid type - TypeError", func() {
+		errs := []error{
+			new(Decimal).UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberBOOL{Value: true}),
+			new(Decimal).Scan(true),
+			new(UnixTimestamp).Scan(true),
+			new(UnixDuration).Scan(true),
+			new(Financial_Common_Tape).UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberBOOL{Value: true}),
+			new(Financial_Common_Tape).Scan(true),
+		}
+
+		for _, err := range errs {
+			Expect(errors.Is(err, utils.ErrUnsupportedType)).Should(BeTrue())
+
+			var typeErr *utils.TypeError
+			Expect(errors.As(err, &typeErr)).Should(BeTrue())
+			Expect(typeErr.Actual).Should(Or(Equal("bool"), Equal("*types.AttributeValueMemberBOOL")))
+		}
+	})
+
+	// Tests that enum lookup failures return an EnumError, including those for a provider or feed
+	It("Enum lookups - Unknown name - EnumError", func() {
+		var class Financial_Common_AssetClass
+		_, provErr := DecodeEnum[Financial_Common_AssetClass](Provider_Polygon, "derp")
+		_, sipErr := TradeConditionFromSIP(CTAFeed, Financial_Common_A, "J")
+		errs := []error{class.UnmarshalCSV("derp"), class.Scan("derp"), provErr, sipErr}
+
+		for _, err := range errs {
+			Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+
+			var enumErr *utils.EnumError
+			Expect(errors.As(err, &enumErr)).Should(BeTrue())
+		}
+	})
+
+	// Tests that an ambiguous name returns an EnumError listing the names it could refer to
+	It("UnmarshalCSV - Ambiguous name - EnumError", func() {
+		var cond Financial_Trades_Condition
+		err := cond.UnmarshalCSV("opra:A ")
+		Expect(errors.Is(err, utils.ErrAmbiguousEnumName)).Should(BeTrue())
+
+		var enumErr *utils.EnumError
+		Expect(errors.As(err, &enumErr)).Should(BeTrue())
+		Expect(enumErr.Candidates).Should(Equal([]string{"OPRA:A", "OPRA:a"}))
+	})
+})
+
+var _ = Describe("Text Encoding Tests", func() {
+
+	// Interfaces implemented by every type that can be converted to and from text
+	type marshaller interface {
+		encoding.TextMarshaler
+		MarshalCSV() (string, error)
+	}
+
+	type unmarshaller interface {
+		encoding.TextUnmarshaler
+	}
+
+	// Tests that enums can be used as JSON map keys, which requires them to be text marshallers
+	It("Enum map keys - JSON - Round trip", func() {
+		values := map[Financial_Common_AssetClass]int{
+			Financial_Common_Stock:  1,
+			Financial_Common_Crypto: 2,
+		}
+
+		data, err := json.Marshal(values)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).Should(Equal(`{"0":1,"2":2}`))
+
+		var decoded map[Financial_Common_AssetClass]int
+		Expect(json.Unmarshal(data, &decoded)).ShouldNot(HaveOccurred())
+		Expect(decoded).Should(Equal(values))
+	})
+
+	// Tests that the text form of each type matches its CSV form, and that it can be read back
+	It("MarshalText, UnmarshalText - Matches CSV - Round trip", func() {
+		dec := &Decimal{Parts: []int64{-12345}, Exp: -3}
+		timestamp := &UnixTimestamp{Seconds: 1654127993, Nanoseconds: 983651350}
+		duration := &UnixDuration{Seconds: 1654127993, Nanoseconds: 983651350}
+		cond := Financial_Trades_RegularSale
+
+		for _, pair := range []struct {
+			value  marshaller
+			result unmarshaller
+		}{
+			{dec, new(Decimal)}, {timestamp, new(UnixTimestamp)}, {duration, new(UnixDuration)}, {&cond, new(Financial_Trades_Condition)},
+		} {
+			text, err := pair.value.MarshalText()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			csv, err := pair.value.MarshalCSV()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(text)).Should(Equal(csv))
+
+			Expect(pair.result.UnmarshalText(text)).ShouldNot(HaveOccurred())
+			again, err := pair.result.(marshaller).MarshalText()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again).Should(Equal(text))
+		}
+	})
+
+	// Tests that UnmarshalText returns the same errors as UnmarshalCSV
+	It("UnmarshalText - Invalid - Error", func() {
+		var class Financial_Common_AssetClass
+		err := class.UnmarshalText([]byte("derp"))
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+
+		err = new(UnixTimestamp).UnmarshalText([]byte("derp"))
+		Expect(errors.Is(err, utils.ErrMalformed)).Should(BeTrue())
+	})
+})
+
+var _ = Describe("YAML Tests", func() {
+
+	// Configuration containing each of the types that can be embedded in a YAML file
+	type config struct {
+		Price      *Decimal                    `yaml:"price"`
+		Start      *UnixTimestamp              `yaml:"start"`
+		Window     *UnixDuration               `yaml:"window"`
+		Class      Financial_Common_AssetClass `yaml:"class"`
+		Conditions TradeConditionSet           `yaml:"conditions"`
+	}
+
+	// Tests that a configuration can be written to YAML and read back again
+	It("Marshal, Unmarshal - Works", func() {
+		cfg := config{
+			Price:      &Decimal{Parts: []int64{-12345}, Exp: -3},
+			Start:      &UnixTimestamp{Seconds: 1654127993, Nanoseconds: 983651350},
+			Window:     &UnixDuration{Seconds: 5400},
+			Class:      Financial_Common_Crypto,
+			Conditions: NewTradeConditionSet(Financial_Trades_RegularSale, Financial_Trades_CashSale),
+		}
+
+		data, err := yaml.Marshal(cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).Should(Equal("price: \"-12.345\"\nstart: \"2022-06-01T23:59:53.98365135Z\"\n" +
+			"window: 1h30m0s\nclass: Crypto\nconditions:\n    - Regular Sale\n    - Cash Sale\n"))
+
+		var parsed config
+		Expect(yaml.Unmarshal(data, &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Price.ToString()).Should(Equal("-12.345"))
+		Expect(parsed.Start).Should(Equal(cfg.Start))
+		Expect(parsed.Window).Should(Equal(cfg.Window))
+		Expect(parsed.Class).Should(Equal(cfg.Class))
+		Expect(parsed.Conditions.Equals(cfg.Conditions)).Should(BeTrue())
+	})
+
+	// Tests that timestamps, durations and decimals can also be read from their epoch or numeric forms
+	It("Unmarshal - Alternate forms - Works", func() {
+		var parsed config
+		err := yaml.Unmarshal([]byte("price: 1.5\nstart: 1654127993983651350\nwindow: \"-1500000000\"\n"), &parsed)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parsed.Price.ToString()).Should(Equal("1.5"))
+		Expect(parsed.Start).Should(Equal(&UnixTimestamp{Seconds: 1654127993, Nanoseconds: 983651350}))
+		Expect(parsed.Window).Should(Equal(&UnixDuration{Seconds: -1, Nanoseconds: -500000000}))
+	})
+
+	// Tests that durations too long to be written in a human-readable form are written as epoch values
+	It("MarshalYAML - Duration too long - Epoch", func() {
+		duration := &UnixDuration{Seconds: 315576000000}
+		data, err := duration.MarshalYAML()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(data).Should(Equal("315576000000000000000"))
+
+		var parsed UnixDuration
+		Expect(yaml.Unmarshal([]byte("315576000000000000000"), &parsed)).ShouldNot(HaveOccurred())
+		Expect(parsed.Seconds).Should(Equal(duration.Seconds))
+	})
+
+	// Tests that invalid YAML values cannot be read
+	It("Unmarshal - Invalid - Error", func() {
+		var parsed config
+		Expect(yaml.Unmarshal([]byte("price: derp"), &parsed)).Should(HaveOccurred())
+		Expect(yaml.Unmarshal([]byte("start: [1]"), &parsed)).Should(HaveOccurred())
+		Expect(yaml.Unmarshal([]byte("window: derp"), &parsed)).Should(HaveOccurred())
+
+		err := yaml.Unmarshal([]byte("start: 10000-01-01T00:00:00Z"), &parsed)
+		Expect(errors.Is(err, utils.ErrMalformed)).Should(BeTrue())
+	})
+})
@@ -0,0 +1,79 @@
+package gopb
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Trade confirmation, in a format similar to that used by FpML, which contains each of the types that
+// can be written to XML as both elements and attributes
+type xmlTrade struct {
+	XMLName    xml.Name                        `xml:"tradeConfirmation"`
+	ID         string                          `xml:"id,attr"`
+	Class      Financial_Common_AssetClass     `xml:"assetClass,attr"`
+	Executed   *UnixTimestamp                  `xml:"executed,attr"`
+	Window     *UnixDuration                   `xml:"window,attr"`
+	Strike     *Decimal                        `xml:"strike,attr"`
+	Missing    *UnixTimestamp                  `xml:"missing,attr"`
+	TradeDate  *UnixTimestamp                  `xml:"tradeDate"`
+	Price      *Decimal                        `xml:"price"`
+	Settlement *UnixDuration                   `xml:"settlementPeriod"`
+	Tape       Financial_Common_Tape           `xml:"tape"`
+	Correction Financial_Trades_CorrectionCode `xml:"correction"`
+	Conditions []Financial_Trades_Condition    `xml:"conditions>condition"`
+}
+
+var _ = Describe("XML Tests", func() {
+
+	// The trade expected to be read from each of the fixtures
+	expected := xmlTrade{
+		XMLName:    xml.Name{Local: "tradeConfirmation"},
+		ID:         "TRD-20220601-0001",
+		Class:      Financial_Common_Option,
+		Executed:   NewUnixTimestamp(1654127993, 983651350),
+		Window:     NewUnixDuration(-1, -500000000),
+		Strike:     &Decimal{Parts: []int64{1255}, Exp: -1},
+		TradeDate:  NewUnixTimestamp(1654041600, 0),
+		Price:      &Decimal{Parts: []int64{234109887750000111, 6554423}, Exp: -3},
+		Settlement: NewUnixDuration(2*secondsInDay+3600, 0),
+		Tape:       Financial_Common_B,
+		Correction: Financial_Trades_LateCorrected,
+		Conditions: []Financial_Trades_Condition{Financial_Trades_RegularSale, Financial_Trades_CashSale},
+	}
+
+	// Helper function that reads a fixture from the testdata directory
+	readFixture := func(name string) []byte {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		Expect(err).ShouldNot(HaveOccurred())
+		return data
+	}
+
+	// Tests that the canonical fixture can be read and that writing it back produces the same document
+	It("Marshal, Unmarshal - Canonical fixture - Round trip", func() {
+		fixture := readFixture("trade.xml")
+
+		var trade xmlTrade
+		Expect(xml.Unmarshal(fixture, &trade)).ShouldNot(HaveOccurred())
+		Expect(trade).Should(Equal(expected))
+
+		data, err := xml.MarshalIndent(trade, "", "  ")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data) + "\n").Should(Equal(string(fixture)))
+	})
+
+	// Tests that a fixture using epoch values, alternate names and local times can be read, and that it is
+	// written back in the canonical form
+	It("Unmarshal - Alternate fixture - Canonical", func() {
+		var trade xmlTrade
+		Expect(xml.Unmarshal(readFixture("trade_alternate.xml"), &trade)).ShouldNot(HaveOccurred())
+		Expect(trade).Should(Equal(expected))
+
+		data, err := xml.MarshalIndent(trade, "", "  ")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data) + "\n").Should(Equal(string(readFixture("trade.xml"))))
+	})
+})
@@ -0,0 +1,635 @@
+package parquetpb
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/file"
+	"github.com/apache/arrow/go/v11/parquet/schema"
+	"github.com/shopspring/decimal"
+	"github.com/xefino/protobuf-gen-go/gopb"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// The greatest and least number of seconds, and nanoseconds in addition to them, that can be written as a
+// TIMESTAMP(NANOS). Since the nanoseconds of a UnixTimestamp are never negative, the lower bound is floored
+var (
+	maxTimestampSeconds, maxTimestampNanos = int64(math.MaxInt64) / int64(time.Second), int32(math.MaxInt64 % int64(time.Second))
+	minTimestampSeconds, minTimestampNanos = int64(math.MinInt64)/int64(time.Second) - 1, int32(math.MinInt64%int64(time.Second) + int64(time.Second))
+)
+
+// Column describes how a field of a record is written to, and read from, a column in a Parquet file. Columns
+// are created with one of the functions in this package, such as DecimalColumn or EnumColumn
+type Column[T any] interface {
+
+	// Name returns the name of the Parquet column
+	Name() string
+
+	// node returns the schema node the column should be written with
+	node() (schema.Node, error)
+
+	// check verifies that a column in a Parquet file can be read into the field
+	check(column *schema.Column) error
+
+	// write writes the field of each record to the column writer provided
+	write(writer file.ColumnChunkWriter, records []T) error
+
+	// read reads the column reader provided into the field of each record
+	read(reader file.ColumnChunkReader, records []T) error
+}
+
+// Helper type describing the column writers that values can be written to in a single batch
+type batchWriter[V any] interface {
+	WriteBatch(values []V, defLevels []int16, repLevels []int16) (int64, error)
+}
+
+// Helper type describing the column readers that values can be read from in batches
+type batchReader[V any] interface {
+	ReadBatch(batchSize int64, values []V, defLvls []int16, repLvls []int16) (int64, int, error)
+	Descriptor() *schema.Column
+}
+
+// decimalColumn writes a Decimal field to a DECIMAL column, or to a UTF8 column if it was created as a
+// string column
+type decimalColumn[T any] struct {
+	name      string
+	precision int32
+	scale     int32
+	asString  bool
+	get       func(T) *gopb.Decimal
+	set       func(*T, *gopb.Decimal)
+}
+
+// DecimalColumn creates a column that writes a Decimal field as a DECIMAL with the precision and scale
+// provided. An error wrapping utils.ErrPrecisionLoss will be returned when writing a value with more
+// decimal places than the scale allows, and a utils.RangeError will be returned when writing a value with
+// more digits than the precision allows; use DecimalStringColumn for fields whose values may not fit. When
+// reading, DECIMAL and UTF8 columns will both be accepted. Nil values are written as null
+func DecimalColumn[T any](name string, precision int32, scale int32, get func(T) *gopb.Decimal,
+	set func(*T, *gopb.Decimal)) Column[T] {
+	return &decimalColumn[T]{name: name, precision: precision, scale: scale, get: get, set: set}
+}
+
+// DecimalStringColumn creates a column that writes a Decimal field as a UTF8 string, so that values of any
+// precision can be written without losing any digits. When reading, DECIMAL and UTF8 columns will both be
+// accepted. Nil values are written as null
+func DecimalStringColumn[T any](name string, get func(T) *gopb.Decimal, set func(*T, *gopb.Decimal)) Column[T] {
+	return &decimalColumn[T]{name: name, asString: true, get: get, set: set}
+}
+
+// Name returns the name of the Parquet column
+func (c *decimalColumn[T]) Name() string {
+	return c.name
+}
+
+// Helper function that returns the UTF8 node for the column if it was created as a string column, or the
+// DECIMAL node otherwise
+func (c *decimalColumn[T]) node() (schema.Node, error) {
+	if c.asString {
+		return DecimalStringNode(c.name), nil
+	}
+
+	return DecimalNode(c.name, c.precision, c.scale)
+}
+
+// Helper function that verifies that a Parquet column contains DECIMAL or UTF8 values
+func (c *decimalColumn[T]) check(column *schema.Column) error {
+	switch column.LogicalType().(type) {
+	case *schema.DecimalLogicalType:
+		return nil
+	case schema.StringLogicalType:
+		if column.PhysicalType() == parquet.Types.ByteArray {
+			return nil
+		}
+	}
+
+	return typeError(column, "Decimal")
+}
+
+// Helper function that writes the Decimal field of each record as either a DECIMAL or a string, depending
+// on the node the column was created with
+func (c *decimalColumn[T]) write(writer file.ColumnChunkWriter, records []T) error {
+
+	// First, get the unscaled value of each record, as a DECIMAL column would store it
+	length := writer.Descr().TypeLength()
+	unscaled := func(i int) (*big.Int, bool, error) {
+		value := c.get(records[i])
+		if value == nil {
+			return nil, false, nil
+		}
+
+		coefficient, err := c.unscaled(value)
+		return coefficient, err == nil, err
+	}
+
+	// Next, write each value to the column according to its physical type
+	switch casted := writer.(type) {
+	case *file.Int32ColumnChunkWriter:
+		return writeBatch[int32](casted, len(records), func(i int) (int32, bool, error) {
+			coefficient, ok, err := unscaled(i)
+			if !ok {
+				return 0, false, err
+			}
+
+			return int32(coefficient.Int64()), true, nil
+		})
+	case *file.Int64ColumnChunkWriter:
+		return writeBatch[int64](casted, len(records), func(i int) (int64, bool, error) {
+			coefficient, ok, err := unscaled(i)
+			if !ok {
+				return 0, false, err
+			}
+
+			return coefficient.Int64(), true, nil
+		})
+	case *file.FixedLenByteArrayColumnChunkWriter:
+		return writeBatch[parquet.FixedLenByteArray](casted, len(records),
+			func(i int) (parquet.FixedLenByteArray, bool, error) {
+				coefficient, ok, err := unscaled(i)
+				if !ok {
+					return nil, false, err
+				}
+
+				return toTwosComplement(coefficient, length), true, nil
+			})
+	case *file.ByteArrayColumnChunkWriter:
+		return writeBatch[parquet.ByteArray](casted, len(records), func(i int) (parquet.ByteArray, bool, error) {
+			value := c.get(records[i])
+			if value == nil {
+				return nil, false, nil
+			}
+
+			return parquet.ByteArray(value.ToString()), true, nil
+		})
+	default:
+		return typeError(writer.Descr(), "Decimal")
+	}
+}
+
+// Helper function that reads a DECIMAL or UTF8 column into the Decimal field of each record
+func (c *decimalColumn[T]) read(reader file.ColumnChunkReader, records []T) error {
+
+	// First, get the scale of the column. UTF8 columns do not have one, but their values are parsed from
+	// strings so it is not needed
+	var scale int32
+	if logical, ok := reader.Descriptor().LogicalType().(*schema.DecimalLogicalType); ok {
+		scale = logical.Scale()
+	}
+
+	set := func(i int, value *gopb.Decimal) {
+		c.set(&records[i], value)
+	}
+
+	// Next, read each value from the column according to its physical type
+	switch casted := reader.(type) {
+	case *file.Int32ColumnChunkReader:
+		return readBatch[int32](casted, len(records), func(i int, value int32, ok bool) error {
+			if ok {
+				set(i, gopb.NewFromDecimal(decimal.New(int64(value), -scale)))
+			}
+
+			return nil
+		})
+	case *file.Int64ColumnChunkReader:
+		return readBatch[int64](casted, len(records), func(i int, value int64, ok bool) error {
+			if ok {
+				set(i, gopb.NewFromDecimal(decimal.New(value, -scale)))
+			}
+
+			return nil
+		})
+	case *file.FixedLenByteArrayColumnChunkReader:
+		return readBatch[parquet.FixedLenByteArray](casted, len(records),
+			func(i int, value parquet.FixedLenByteArray, ok bool) error {
+				if ok {
+					set(i, gopb.NewFromDecimal(decimal.NewFromBigInt(fromTwosComplement(value), -scale)))
+				}
+
+				return nil
+			})
+	case *file.ByteArrayColumnChunkReader:
+		_, isDecimal := reader.Descriptor().LogicalType().(*schema.DecimalLogicalType)
+		return readBatch[parquet.ByteArray](casted, len(records), func(i int, value parquet.ByteArray, ok bool) error {
+			if !ok {
+				return nil
+			} else if isDecimal {
+				set(i, gopb.NewFromDecimal(decimal.NewFromBigInt(fromTwosComplement(value), -scale)))
+				return nil
+			}
+
+			parsed := new(gopb.Decimal)
+			if err := parsed.FromString(string(value)); err != nil {
+				return err
+			}
+
+			set(i, parsed)
+			return nil
+		})
+	default:
+		return typeError(reader.Descriptor(), "Decimal")
+	}
+}
+
+// Helper function that returns the unscaled value of a Decimal, as it would be stored in the column. An
+// error wrapping utils.ErrPrecisionLoss will be returned if the value has more decimal places than the
+// scale of the column, and a utils.RangeError will be returned if it has more digits than the precision
+// allows
+func (c *decimalColumn[T]) unscaled(value *gopb.Decimal) (*big.Int, error) {
+
+	// First, shift the value by the scale so that it can be stored as an integer. If this leaves a
+	// fractional part then the value cannot be stored without losing precision
+	scaled := value.ToDecimal().Shift(c.scale)
+	if !scaled.IsInteger() {
+		return nil, fmt.Errorf("Decimal (%s) has more than %d decimal places: %w",
+			value.ToString(), c.scale, utils.ErrPrecisionLoss)
+	}
+
+	// Next, check that the value has no more digits than the precision allows
+	coefficient := scaled.BigInt()
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.precision)), nil)
+	if new(big.Int).Abs(coefficient).Cmp(limit) >= 0 {
+		bound := decimal.NewFromBigInt(limit.Sub(limit, big.NewInt(1)), -c.scale)
+		if coefficient.Sign() < 0 {
+			return nil, &utils.RangeError{Type: "Decimal", Value: value.ToString(), Bound: bound.Neg().String(),
+				Err: utils.ErrUnderflow}
+		}
+
+		return nil, &utils.RangeError{Type: "Decimal", Value: value.ToString(), Bound: bound.String(),
+			Err: utils.ErrOverflow}
+	}
+
+	return coefficient, nil
+}
+
+// timestampColumn writes a UnixTimestamp field to a TIMESTAMP(NANOS, UTC) column
+type timestampColumn[T any] struct {
+	name string
+	get  func(T) *gopb.UnixTimestamp
+	set  func(*T, *gopb.UnixTimestamp)
+}
+
+// TimestampColumn creates a column that writes a UnixTimestamp field as a TIMESTAMP(NANOS, UTC). When
+// reading, timestamps with millisecond or microsecond units will also be accepted. Nil values are written
+// as null, and a utils.RangeError will be returned if a timestamp is outside the range that can be
+// represented in nanoseconds, which is between 1677 and 2262
+func TimestampColumn[T any](name string, get func(T) *gopb.UnixTimestamp,
+	set func(*T, *gopb.UnixTimestamp)) Column[T] {
+	return &timestampColumn[T]{name: name, get: get, set: set}
+}
+
+// Name returns the name of the Parquet column
+func (c *timestampColumn[T]) Name() string {
+	return c.name
+}
+
+// Helper function that returns the TIMESTAMP node for the column
+func (c *timestampColumn[T]) node() (schema.Node, error) {
+	return TimestampNode(c.name), nil
+}
+
+// Helper function that verifies that a Parquet column contains TIMESTAMP values
+func (c *timestampColumn[T]) check(column *schema.Column) error {
+	if _, ok := column.LogicalType().(*schema.TimestampLogicalType); !ok ||
+		column.PhysicalType() != parquet.Types.Int64 {
+		return typeError(column, "UnixTimestamp")
+	}
+
+	return nil
+}
+
+// Helper function that writes the UnixTimestamp field of each record as a number of nanoseconds
+func (c *timestampColumn[T]) write(writer file.ColumnChunkWriter, records []T) error {
+	casted, ok := writer.(*file.Int64ColumnChunkWriter)
+	if !ok {
+		return typeError(writer.Descr(), "UnixTimestamp")
+	}
+
+	return writeBatch[int64](casted, len(records), func(i int) (int64, bool, error) {
+		value := c.get(records[i])
+		if value == nil {
+			return 0, false, nil
+		}
+
+		if err := value.CheckValid(); err != nil {
+			return 0, false, err
+		}
+
+		nanos, err := toNanos(value)
+		return nanos, err == nil, err
+	})
+}
+
+// Helper function that reads a TIMESTAMP column into the UnixTimestamp field of each record
+func (c *timestampColumn[T]) read(reader file.ColumnChunkReader, records []T) error {
+	casted, ok := reader.(*file.Int64ColumnChunkReader)
+	if !ok {
+		return typeError(reader.Descriptor(), "UnixTimestamp")
+	}
+
+	// Determine how many of the column's units are in a second, and how many nanoseconds are in each unit
+	var perSecond, multiplier int64
+	switch reader.Descriptor().LogicalType().(*schema.TimestampLogicalType).TimeUnit() {
+	case schema.TimeUnitMillis:
+		perSecond, multiplier = int64(time.Second/time.Millisecond), int64(time.Millisecond)
+	case schema.TimeUnitMicros:
+		perSecond, multiplier = int64(time.Second/time.Microsecond), int64(time.Microsecond)
+	default:
+		perSecond, multiplier = int64(time.Second), 1
+	}
+
+	return readBatch[int64](casted, len(records), func(i int, value int64, ok bool) error {
+		if !ok {
+			return nil
+		}
+
+		// Floor the seconds so that the nanoseconds are never negative
+		seconds, fraction := value/perSecond, value%perSecond
+		if fraction < 0 {
+			seconds, fraction = seconds-1, fraction+perSecond
+		}
+
+		c.set(&records[i], gopb.NewUnixTimestamp(seconds, int32(fraction*multiplier)))
+		return nil
+	})
+}
+
+// enumColumn writes an enum field to an ENUM column
+type enumColumn[T any, E utils.ProtoEnum] struct {
+	name string
+	get  func(T) E
+	set  func(*T, E)
+}
+
+// EnumColumn creates a column that writes an enum field as an ENUM, using the same name the value is
+// written with in JSON. When reading, UTF8 columns will also be accepted. Values equivalent to
+// utils.NoValue are written as null
+func EnumColumn[T any, E utils.ProtoEnum](name string, get func(T) E, set func(*T, E)) Column[T] {
+	return &enumColumn[T, E]{name: name, get: get, set: set}
+}
+
+// Name returns the name of the Parquet column
+func (c *enumColumn[T, E]) Name() string {
+	return c.name
+}
+
+// Helper function that returns the ENUM node for the column
+func (c *enumColumn[T, E]) node() (schema.Node, error) {
+	return EnumNode(c.name), nil
+}
+
+// Helper function that verifies that a Parquet column contains ENUM or UTF8 values
+func (c *enumColumn[T, E]) check(column *schema.Column) error {
+	switch column.LogicalType().(type) {
+	case schema.EnumLogicalType, schema.StringLogicalType:
+		if column.PhysicalType() == parquet.Types.ByteArray {
+			return nil
+		}
+	}
+
+	return typeError(column, fmt.Sprintf("%T", E(0)))
+}
+
+// Helper function that writes the enum field of each record as its name
+func (c *enumColumn[T, E]) write(writer file.ColumnChunkWriter, records []T) error {
+	casted, ok := writer.(*file.ByteArrayColumnChunkWriter)
+	if !ok {
+		return typeError(writer.Descr(), fmt.Sprintf("%T", E(0)))
+	}
+
+	return writeBatch[parquet.ByteArray](casted, len(records), func(i int) (parquet.ByteArray, bool, error) {
+		value := c.get(records[i])
+		if !utils.HasValue(value) {
+			return nil, false, nil
+		}
+
+		return parquet.ByteArray(utils.Describe(value).OutputName), true, nil
+	})
+}
+
+// Helper function that reads an ENUM or UTF8 column into the enum field of each record. Since each name
+// is likely to appear many times, the value of each name is only parsed once
+func (c *enumColumn[T, E]) read(reader file.ColumnChunkReader, records []T) error {
+	casted, ok := reader.(*file.ByteArrayColumnChunkReader)
+	if !ok {
+		return typeError(reader.Descriptor(), fmt.Sprintf("%T", E(0)))
+	}
+
+	parsed := make(map[string]E)
+	return readBatch[parquet.ByteArray](casted, len(records), func(i int, raw parquet.ByteArray, ok bool) error {
+		if !ok {
+			c.set(&records[i], utils.NoValue[E]())
+			return nil
+		}
+
+		value, found := parsed[string(raw)]
+		if !found {
+			var err error
+			if value, err = utils.Parse[E](string(raw)); err != nil {
+				return err
+			}
+
+			parsed[string(raw)] = value
+		}
+
+		c.set(&records[i], value)
+		return nil
+	})
+}
+
+// primitiveColumn writes a field with a Go type that Parquet supports natively to a required column
+type primitiveColumn[T any, V any] struct {
+	name     string
+	physical parquet.Type
+	logical  schema.LogicalType
+	get      func(T) V
+	set      func(*T, V)
+}
+
+// StringColumn creates a column that writes a string field as a required UTF8
+func StringColumn[T any](name string, get func(T) string, set func(*T, string)) Column[T] {
+	return &primitiveColumn[T, string]{name: name, physical: parquet.Types.ByteArray,
+		logical: schema.StringLogicalType{}, get: get, set: set}
+}
+
+// Int32Column creates a column that writes an int32 field as a required INT32
+func Int32Column[T any](name string, get func(T) int32, set func(*T, int32)) Column[T] {
+	return &primitiveColumn[T, int32]{name: name, physical: parquet.Types.Int32,
+		logical: schema.NoLogicalType{}, get: get, set: set}
+}
+
+// Int64Column creates a column that writes an int64 field as a required INT64
+func Int64Column[T any](name string, get func(T) int64, set func(*T, int64)) Column[T] {
+	return &primitiveColumn[T, int64]{name: name, physical: parquet.Types.Int64,
+		logical: schema.NoLogicalType{}, get: get, set: set}
+}
+
+// Name returns the name of the Parquet column
+func (c *primitiveColumn[T, V]) Name() string {
+	return c.name
+}
+
+// Helper function that returns the required node for the column
+func (c *primitiveColumn[T, V]) node() (schema.Node, error) {
+	return schema.NewPrimitiveNodeLogical(c.name, parquet.Repetitions.Required, c.logical, c.physical, -1, -1)
+}
+
+// Helper function that verifies that a Parquet column has the physical type of the field
+func (c *primitiveColumn[T, V]) check(column *schema.Column) error {
+	if column.PhysicalType() != c.physical {
+		var value V
+		return typeError(column, fmt.Sprintf("%T", value))
+	}
+
+	return nil
+}
+
+// Helper function that writes the field of each record to the column
+func (c *primitiveColumn[T, V]) write(writer file.ColumnChunkWriter, records []T) error {
+	switch casted := writer.(type) {
+	case *file.ByteArrayColumnChunkWriter:
+		return writeBatch[parquet.ByteArray](casted, len(records), func(i int) (parquet.ByteArray, bool, error) {
+			return parquet.ByteArray(any(c.get(records[i])).(string)), true, nil
+		})
+	case *file.Int32ColumnChunkWriter:
+		return writeBatch[int32](casted, len(records), func(i int) (int32, bool, error) {
+			return any(c.get(records[i])).(int32), true, nil
+		})
+	case *file.Int64ColumnChunkWriter:
+		return writeBatch[int64](casted, len(records), func(i int) (int64, bool, error) {
+			return any(c.get(records[i])).(int64), true, nil
+		})
+	default:
+		var value V
+		return typeError(writer.Descr(), fmt.Sprintf("%T", value))
+	}
+}
+
+// Helper function that reads the column into the field of each record. Null values in an optional column
+// are read as the zero value of the field
+func (c *primitiveColumn[T, V]) read(reader file.ColumnChunkReader, records []T) error {
+	set := func(i int, value interface{}, ok bool) error {
+		if ok {
+			c.set(&records[i], value.(V))
+		}
+
+		return nil
+	}
+
+	switch casted := reader.(type) {
+	case *file.ByteArrayColumnChunkReader:
+		return readBatch[parquet.ByteArray](casted, len(records), func(i int, value parquet.ByteArray, ok bool) error {
+			return set(i, string(value), ok)
+		})
+	case *file.Int32ColumnChunkReader:
+		return readBatch[int32](casted, len(records), func(i int, value int32, ok bool) error {
+			return set(i, value, ok)
+		})
+	case *file.Int64ColumnChunkReader:
+		return readBatch[int64](casted, len(records), func(i int, value int64, ok bool) error {
+			return set(i, value, ok)
+		})
+	default:
+		var value V
+		return typeError(reader.Descriptor(), fmt.Sprintf("%T", value))
+	}
+}
+
+// Helper function that writes a value for each of a number of records to a column in a single batch. The
+// get function returns the value for each record and whether it is present; values that are not present
+// are written as null
+func writeBatch[V any](writer batchWriter[V], count int, get func(int) (V, bool, error)) error {
+	values := make([]V, 0, count)
+	levels := make([]int16, count)
+	for i := range levels {
+		value, ok, err := get(i)
+		if err != nil {
+			return err
+		} else if ok {
+			values = append(values, value)
+			levels[i] = 1
+		}
+	}
+
+	_, err := writer.WriteBatch(values, levels, nil)
+	return err
+}
+
+// Helper function that reads a value for each of a number of records from a column, calling the set
+// function with each value and whether it is present
+func readBatch[V any](reader batchReader[V], count int, set func(int, V, bool) error) error {
+	maxLevel := reader.Descriptor().MaxDefinitionLevel()
+	values := make([]V, count)
+	levels := make([]int16, count)
+	for row := 0; row < count; {
+
+		// Read as many of the remaining values as possible from the column
+		total, _, err := reader.ReadBatch(int64(count-row), values, levels, nil)
+		if err != nil {
+			return err
+		} else if total == 0 {
+			return fmt.Errorf("Parquet column %q ended after %d of %d values",
+				reader.Descriptor().Name(), row, count)
+		}
+
+		// Pair each of the values read with its record, using the definition levels to determine which
+		// of the records have a null value
+		var next int
+		var zero V
+		for _, level := range levels[:total] {
+			if level == maxLevel {
+				if err := set(row, values[next], true); err != nil {
+					return err
+				}
+
+				next++
+			} else if err := set(row, zero, false); err != nil {
+				return err
+			}
+
+			row++
+		}
+	}
+
+	return nil
+}
+
+// Helper function that converts a UnixTimestamp to a number of nanoseconds since the UNIX epoch, returning
+// a utils.RangeError if the result does not fit in an int64
+func toNanos(value *gopb.UnixTimestamp) (int64, error) {
+	switch {
+	case value.Seconds > maxTimestampSeconds ||
+		(value.Seconds == maxTimestampSeconds && value.Nanoseconds > maxTimestampNanos):
+		return 0, &utils.RangeError{Type: "timestamp", Value: value.ToDateTime(),
+			Bound: time.Unix(0, math.MaxInt64).UTC().Format(time.RFC3339Nano), Err: utils.ErrOverflow}
+	case value.Seconds < minTimestampSeconds ||
+		(value.Seconds == minTimestampSeconds && value.Nanoseconds < minTimestampNanos):
+		return 0, &utils.RangeError{Type: "timestamp", Value: value.ToDateTime(),
+			Bound: time.Unix(0, math.MinInt64).UTC().Format(time.RFC3339Nano), Err: utils.ErrUnderflow}
+	default:
+		return value.Seconds*int64(time.Second) + int64(value.Nanoseconds), nil
+	}
+}
+
+// Helper function that converts an integer to a big-endian, two's complement byte array of the length
+// provided. The integer is assumed to fit in the array
+func toTwosComplement(value *big.Int, length int) []byte {
+	raw := value
+	if value.Sign() < 0 {
+		raw = new(big.Int).Add(value, new(big.Int).Lsh(big.NewInt(1), uint(8*length)))
+	}
+
+	return raw.FillBytes(make([]byte, length))
+}
+
+// Helper function that converts a big-endian, two's complement byte array to an integer
+func fromTwosComplement(raw []byte) *big.Int {
+	value := new(big.Int).SetBytes(raw)
+	if len(raw) > 0 && raw[0]&0x80 != 0 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(8*len(raw))))
+	}
+
+	return value
+}
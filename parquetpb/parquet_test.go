@@ -0,0 +1,446 @@
+package parquetpb
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/file"
+	"github.com/apache/arrow/go/v11/parquet/schema"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/xefino/protobuf-gen-go/gopb"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+var _ = Describe("Parquet Type Tests", func() {
+
+	// Tests that the DecimalNode function maps precisions to the correct physical type
+	DescribeTable("DecimalNode - Works",
+		func(precision int32, physical parquet.Type, length int) {
+			node, err := DecimalNode("price", precision, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(node.PhysicalType()).Should(Equal(physical))
+			Expect(node.TypeLength()).Should(Equal(length))
+			Expect(node.RepetitionType()).Should(Equal(parquet.Repetitions.Optional))
+			Expect(node.LogicalType().Equals(schema.NewDecimalLogicalType(precision, 2))).Should(BeTrue())
+		},
+		Entry("Precision of 9 - INT32", int32(9), parquet.Types.Int32, -1),
+		Entry("Precision of 18 - INT64", int32(18), parquet.Types.Int64, -1),
+		Entry("Precision of 19 - FIXED_LEN_BYTE_ARRAY(9)", int32(19), parquet.Types.FixedLenByteArray, 9),
+		Entry("Precision of 38 - FIXED_LEN_BYTE_ARRAY(16)", int32(38), parquet.Types.FixedLenByteArray, 16))
+
+	// Tests that the DecimalNode function returns an error if the precision or scale is invalid
+	DescribeTable("DecimalNode - Invalid - Error",
+		func(precision int32, scale int32, typ string, inner error) {
+			node, err := DecimalNode("price", precision, scale)
+			Expect(node).Should(BeNil())
+
+			var rErr *utils.RangeError
+			Expect(errors.As(err, &rErr)).Should(BeTrue())
+			Expect(rErr.Type).Should(Equal(typ))
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+		},
+		Entry("Precision less than 1 - Underflow", int32(0), int32(0), "decimal precision", utils.ErrUnderflow),
+		Entry("Negative scale - Underflow", int32(10), int32(-1), "decimal scale", utils.ErrUnderflow),
+		Entry("Scale greater than precision - Overflow", int32(4), int32(5), "decimal scale", utils.ErrOverflow))
+
+	// Tests that the TimestampNode and EnumNode functions create nodes with the correct logical types
+	It("TimestampNode, EnumNode - Works", func() {
+		timestamp := TimestampNode("timestamp")
+		Expect(timestamp.PhysicalType()).Should(Equal(parquet.Types.Int64))
+		Expect(timestamp.LogicalType().String()).Should(Equal("Timestamp(isAdjustedToUTC=true, timeUnit=nanoseconds, " +
+			"is_from_converted_type=false, force_set_converted_type=false)"))
+
+		enum := EnumNode("tape")
+		Expect(enum.PhysicalType()).Should(Equal(parquet.Types.ByteArray))
+		Expect(enum.LogicalType().Equals(schema.EnumLogicalType{})).Should(BeTrue())
+	})
+})
+
+var _ = Describe("Parquet Writer and Reader Tests", func() {
+
+	// Helper function that creates the columns for a trade, with a price of the precision and scale provided
+	tradeColumns := func(precision int32, scale int32) []Column[gopb.Trade] {
+		return []Column[gopb.Trade]{
+			StringColumn("id", func(t gopb.Trade) string { return t.ID },
+				func(t *gopb.Trade, v string) { t.ID = v }),
+			Int64Column("sequence_number", func(t gopb.Trade) int64 { return t.SequenceNumber },
+				func(t *gopb.Trade, v int64) { t.SequenceNumber = v }),
+			TimestampColumn("timestamp", func(t gopb.Trade) *gopb.UnixTimestamp { return t.Timestamp },
+				func(t *gopb.Trade, v *gopb.UnixTimestamp) { t.Timestamp = v }),
+			DecimalColumn("price", precision, scale, func(t gopb.Trade) *gopb.Decimal { return t.Price },
+				func(t *gopb.Trade, v *gopb.Decimal) { t.Price = v }),
+			EnumColumn("correction", func(t gopb.Trade) gopb.Financial_Trades_CorrectionCode { return t.Correction },
+				func(t *gopb.Trade, v gopb.Financial_Trades_CorrectionCode) { t.Correction = v }),
+			EnumColumn("tape", func(t gopb.Trade) gopb.Financial_Common_Tape { return t.Tape },
+				func(t *gopb.Trade, v gopb.Financial_Common_Tape) { t.Tape = v }),
+		}
+	}
+
+	// Helper function that creates a trade with the price provided
+	newTrade := func(id string, price string) gopb.Trade {
+		trade := gopb.Trade{
+			ID:             id,
+			SequenceNumber: 42,
+			Timestamp:      gopb.NewUnixTimestamp(1654127993, 983000001),
+			Correction:     gopb.Financial_Trades_LateCorrected,
+			Tape:           gopb.Financial_Common_B,
+		}
+
+		if price != "" {
+			trade.Price = new(gopb.Decimal)
+			Expect(trade.Price.FromString(price)).ShouldNot(HaveOccurred())
+		}
+
+		return trade
+	}
+
+	// Helper function that writes records to a Parquet file, returning the path to the file
+	writeFile := func(columns []Column[gopb.Trade], trades ...gopb.Trade) string {
+		path := filepath.Join(GinkgoT().TempDir(), "trades.parquet")
+		out, err := os.Create(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		writer, err := NewWriter(out, columns)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(writer.Write(trades...)).ShouldNot(HaveOccurred())
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+		return path
+	}
+
+	// Helper function that reads records from a Parquet file
+	readFile := func(path string, columns []Column[gopb.Trade]) []gopb.Trade {
+		in, err := os.Open(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		reader, err := NewReader(in, columns)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer reader.Close()
+
+		records, err := reader.Read()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(records).Should(HaveLen(int(reader.NumRows())))
+		return records
+	}
+
+	// Helper function that returns the schema column with the name provided from a Parquet file
+	fileColumn := func(path string, name string) *schema.Column {
+		reader, err := file.OpenParquetFile(path, false)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer reader.Close()
+
+		sc := reader.MetaData().Schema
+		index := sc.ColumnIndexByName(name)
+		Expect(index).ShouldNot(BeNumerically("<", 0))
+		return sc.Column(index)
+	}
+
+	// Tests that trades can be written to a Parquet file, with the logical types of their fields, and
+	// read back again
+	DescribeTable("Write, Read - Works",
+		func(precision int32, scale int32, physical parquet.Type, prices ...string) {
+
+			// First, write a trade for each price, along with a trade with no values, to the file
+			columns := tradeColumns(precision, scale)
+			trades := make([]gopb.Trade, 0, len(prices)+1)
+			for _, price := range prices {
+				trades = append(trades, newTrade("T"+price, price))
+			}
+
+			trades = append(trades, gopb.Trade{ID: "empty", Correction: utils.NoValue[gopb.Financial_Trades_CorrectionCode](),
+				Tape: utils.NoValue[gopb.Financial_Common_Tape]()})
+			path := writeFile(columns, trades...)
+
+			// Next, verify that each column was written with the expected type
+			price := fileColumn(path, "price")
+			Expect(price.PhysicalType()).Should(Equal(physical))
+			Expect(price.LogicalType().Equals(schema.NewDecimalLogicalType(precision, scale))).Should(BeTrue())
+			Expect(fileColumn(path, "timestamp").LogicalType().Equals(
+				schema.NewTimestampLogicalType(true, schema.TimeUnitNanos))).Should(BeTrue())
+			Expect(fileColumn(path, "correction").LogicalType().Equals(schema.EnumLogicalType{})).Should(BeTrue())
+			Expect(fileColumn(path, "id").LogicalType().Equals(schema.StringLogicalType{})).Should(BeTrue())
+
+			// Finally, read the trades back and verify that they match the trades that were written
+			read := readFile(path, columns)
+			Expect(read).Should(HaveLen(len(trades)))
+			for i, trade := range read[:len(prices)] {
+				Expect(trade.ID).Should(Equal("T" + prices[i]))
+				Expect(trade.SequenceNumber).Should(Equal(int64(42)))
+				Expect(trade.Timestamp).Should(Equal(gopb.NewUnixTimestamp(1654127993, 983000001)))
+				Expect(trade.Price.ToString()).Should(Equal(prices[i]))
+				Expect(trade.Correction).Should(Equal(gopb.Financial_Trades_LateCorrected))
+				Expect(trade.Tape).Should(Equal(gopb.Financial_Common_B))
+			}
+
+			empty := read[len(prices)]
+			Expect(empty.ID).Should(Equal("empty"))
+			Expect(empty.SequenceNumber).Should(BeZero())
+			Expect(empty.Timestamp).Should(BeNil())
+			Expect(empty.Price).Should(BeNil())
+			Expect(empty.Correction).Should(Equal(utils.NoValue[gopb.Financial_Trades_CorrectionCode]()))
+			Expect(empty.Tape).Should(Equal(utils.NoValue[gopb.Financial_Common_Tape]()))
+		},
+		Entry("INT32 - Works", int32(9), int32(2), parquet.Types.Int32, "123.45", "-0.01", "9999999.99"),
+		Entry("INT64 - Works", int32(18), int32(4), parquet.Types.Int64, "123.4567", "-99999999999999.9999"),
+		Entry("FIXED_LEN_BYTE_ARRAY - Works", int32(30), int32(6), parquet.Types.FixedLenByteArray,
+			"123456789012345678901234.123456", "-123456789012345678901234.123456", "-0.000001", "0"))
+
+	// Tests that a decimal column returns an error, rather than changing its type, if a value written to it
+	// does not fit in its precision and scale
+	DescribeTable("Write - Decimal exceeds column - Error",
+		func(price string, inner error) {
+			writer, err := NewWriter(io.Discard, tradeColumns(9, 2))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(writer.Write(newTrade("T1", "1.25"), newTrade("T2", price))).ShouldNot(HaveOccurred())
+
+			err = writer.Close()
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+			Expect(err.Error()).Should(HaveSuffix(`for column "price"`))
+		},
+		Entry("Too many digits - Overflow", "123456789", utils.ErrOverflow),
+		Entry("Too many decimal places - Precision loss", "1.125", utils.ErrPrecisionLoss),
+		Entry("Too many digits, negative - Underflow", "-10000000", utils.ErrUnderflow))
+
+	// Tests that a decimal string column writes values of any precision as UTF8, that the writer reports
+	// the type the column was written with, and that the values can be read back by a DECIMAL column
+	It("Write, Read - Decimal string column - Works", func() {
+		columns := tradeColumns(9, 2)
+		columns[3] = DecimalStringColumn("price", func(t gopb.Trade) *gopb.Decimal { return t.Price },
+			func(t *gopb.Trade, v *gopb.Decimal) { t.Price = v })
+
+		writer, err := NewWriter(io.Discard, columns)
+		Expect(err).ShouldNot(HaveOccurred())
+		reported := writer.Schema().Column(writer.Schema().ColumnIndexByName("price"))
+		Expect(reported.LogicalType().Equals(schema.StringLogicalType{})).Should(BeTrue())
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+
+		path := writeFile(columns, newTrade("T1", "1.25"), newTrade("T2", "123456789.125"), newTrade("T3", ""))
+		column := fileColumn(path, "price")
+		Expect(column.PhysicalType()).Should(Equal(parquet.Types.ByteArray))
+		Expect(column.LogicalType().Equals(schema.StringLogicalType{})).Should(BeTrue())
+
+		read := readFile(path, tradeColumns(9, 2))
+		Expect(read).Should(HaveLen(3))
+		Expect(read[0].Price.ToString()).Should(Equal("1.25"))
+		Expect(read[1].Price.ToString()).Should(Equal("123456789.125"))
+		Expect(read[2].Price).Should(BeNil())
+	})
+
+	// Tests that the writer writes a row group each time enough records have been written to fill one,
+	// rather than buffering every record until it is closed
+	It("Write - Row group length - Works", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "trades.parquet")
+		out, err := os.Create(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		writer, err := NewWriter(out, tradeColumns(9, 2), parquet.WithMaxRowGroupLength(2))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(writer.Write(newTrade("T1", "1.25"), newTrade("T2", "2.50"), newTrade("T3", "3.75"))).
+			ShouldNot(HaveOccurred())
+		Expect(writer.records).Should(HaveLen(1))
+		Expect(writer.writer.NumRowGroups()).Should(Equal(1))
+
+		Expect(writer.Write(newTrade("T4", ""), newTrade("T5", "5.00"))).ShouldNot(HaveOccurred())
+		Expect(writer.records).Should(HaveLen(1))
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+
+		reader, err := file.OpenParquetFile(path, false)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(reader.NumRowGroups()).Should(Equal(3))
+		Expect(reader.Close()).ShouldNot(HaveOccurred())
+
+		read := readFile(path, tradeColumns(9, 2))
+		Expect(read).Should(HaveLen(5))
+		Expect(read[4].ID).Should(Equal("T5"))
+	})
+
+	// Tests that, if writing a row group fails, the error is returned by every later call and the output
+	// is still closed
+	It("Write, Close - Row group fails - Output closed", func() {
+		out := &closeRecorder{}
+		writer, err := NewWriter(out, tradeColumns(9, 2), parquet.WithMaxRowGroupLength(1))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = writer.Write(newTrade("T1", "1.25"), newTrade("T2", "1.125"), newTrade("T3", "1.25"))
+		Expect(errors.Is(err, utils.ErrPrecisionLoss)).Should(BeTrue())
+		Expect(writer.Write(newTrade("T4", "1.25"))).Should(Equal(err))
+		Expect(out.closed).Should(BeFalse())
+
+		Expect(writer.Close()).Should(Equal(err))
+		Expect(out.closed).Should(BeTrue())
+		Expect(writer.Close()).Should(Equal(err))
+	})
+
+	// Tests that an enum column can be read from a UTF8 column, as might be written by another tool
+	It("Read - Enum from UTF8 - Works", func() {
+		written := []Column[gopb.Trade]{
+			StringColumn("tape", func(t gopb.Trade) string { return t.ID }, nil),
+		}
+
+		path := writeFile(written, gopb.Trade{ID: "A"}, gopb.Trade{ID: "c"})
+		read := readFile(path, []Column[gopb.Trade]{tradeColumns(9, 2)[5]})
+		Expect(read).Should(HaveLen(2))
+		Expect(read[0].Tape).Should(Equal(gopb.Financial_Common_A))
+		Expect(read[1].Tape).Should(Equal(gopb.Financial_Common_C))
+	})
+
+	// Tests that timestamps in units other than nanoseconds can be read, including those before the epoch
+	It("Read - Millisecond timestamps - Works", func() {
+
+		// First, write a file containing millisecond timestamps directly
+		node := schema.MustPrimitive(schema.NewPrimitiveNodeLogical("timestamp", parquet.Repetitions.Optional,
+			schema.NewTimestampLogicalType(true, schema.TimeUnitMillis), parquet.Types.Int64, -1, -1))
+		root := schema.MustGroup(schema.NewGroupNode("schema", parquet.Repetitions.Required, schema.FieldList{node}, -1))
+
+		path := filepath.Join(GinkgoT().TempDir(), "millis.parquet")
+		out, err := os.Create(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		writer := file.NewParquetWriter(out, root)
+		group := writer.AppendRowGroup()
+		chunk, err := group.NextColumn()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = chunk.(*file.Int64ColumnChunkWriter).WriteBatch([]int64{1654127993983, -1500}, []int16{1, 0, 1}, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(chunk.Close()).ShouldNot(HaveOccurred())
+		Expect(group.Close()).ShouldNot(HaveOccurred())
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+
+		// Next, read the timestamps back and verify that they were converted correctly
+		read := readFile(path, []Column[gopb.Trade]{tradeColumns(9, 2)[2]})
+		Expect(read).Should(HaveLen(3))
+		Expect(read[0].Timestamp).Should(Equal(gopb.NewUnixTimestamp(1654127993, 983000000)))
+		Expect(read[1].Timestamp).Should(BeNil())
+		Expect(read[2].Timestamp).Should(Equal(gopb.NewUnixTimestamp(-2, 500000000)))
+	})
+
+	// Tests that the writer returns an error if a timestamp cannot be represented in nanoseconds
+	DescribeTable("Close - Timestamp out of range - Error",
+		func(seconds int64, bound string, inner error) {
+			trade := newTrade("T1", "1.25")
+			trade.Timestamp = gopb.NewUnixTimestamp(seconds, 0)
+
+			writer, err := NewWriter(io.Discard, tradeColumns(9, 2))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(writer.Write(trade)).ShouldNot(HaveOccurred())
+
+			err = writer.Close()
+			var rErr *utils.RangeError
+			Expect(errors.As(err, &rErr)).Should(BeTrue())
+			Expect(rErr.Bound).Should(Equal(bound))
+			Expect(errors.Is(err, inner)).Should(BeTrue())
+			Expect(err.Error()).Should(HaveSuffix(`for column "timestamp"`))
+		},
+		Entry("After 2262 - Overflow", int64(9300000000), "2262-04-11T23:47:16.854775807Z", utils.ErrOverflow),
+		Entry("Before 1677 - Underflow", int64(-9300000000), "1677-09-21T00:12:43.145224192Z", utils.ErrUnderflow))
+
+	// Tests that the writer can write the earliest and latest timestamps that fit in nanoseconds
+	It("Write, Read - Timestamp limits - Works", func() {
+		columns := tradeColumns(9, 2)
+		first, last := newTrade("first", ""), newTrade("last", "")
+		first.Timestamp = gopb.NewUnixTimestamp(-9223372037, 145224192)
+		last.Timestamp = gopb.NewUnixTimestamp(9223372036, 854775807)
+
+		read := readFile(writeFile(columns, first, last), columns)
+		Expect(read[0].Timestamp).Should(Equal(first.Timestamp))
+		Expect(read[1].Timestamp).Should(Equal(last.Timestamp))
+	})
+
+	// Tests that the NewWriter function returns an error if the columns are invalid
+	It("NewWriter - Invalid columns - Error", func() {
+		_, err := NewWriter[gopb.Trade](io.Discard, nil)
+		Expect(err).Should(MatchError("Parquet writer requires at least one column"))
+
+		columns := tradeColumns(9, 2)
+		_, err = NewWriter(io.Discard, append(columns, columns[0]))
+		Expect(err).Should(MatchError(`Parquet column "id" was defined more than once`))
+
+		_, err = NewWriter(io.Discard, tradeColumns(9, 10))
+		Expect(errors.Is(err, utils.ErrOverflow)).Should(BeTrue())
+		Expect(err.Error()).Should(HaveSuffix(`for column "price"`))
+	})
+
+	// Tests that the writer cannot be written to once it has been closed
+	It("Write - Closed - Error", func() {
+		writer, err := NewWriter(io.Discard, tradeColumns(9, 2))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+		Expect(writer.Close()).ShouldNot(HaveOccurred())
+		Expect(writer.Write(newTrade("T1", "1.25"))).Should(MatchError("Parquet writer was already closed"))
+	})
+
+	// Tests that the NewReader function returns an error if a column is missing from the file
+	It("NewReader - Missing column - Error", func() {
+		columns := tradeColumns(9, 2)
+		path := writeFile(columns[:1], newTrade("T1", "1.25"))
+
+		in, err := os.Open(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer in.Close()
+
+		reader, err := NewReader(in, columns)
+		Expect(reader).Should(BeNil())
+		Expect(err).Should(MatchError(`Parquet file does not contain a column named "sequence_number"`))
+	})
+
+	// Tests that the NewReader function returns an error if a column has a type that cannot be read into
+	// its field
+	It("NewReader - Wrong type - Error", func() {
+		columns := tradeColumns(9, 2)
+		path := writeFile(columns, newTrade("T1", "1.25"))
+
+		in, err := os.Open(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer in.Close()
+
+		wrong := []Column[gopb.Trade]{
+			DecimalColumn("timestamp", 9, 2, func(t gopb.Trade) *gopb.Decimal { return t.Price },
+				func(t *gopb.Trade, v *gopb.Decimal) { t.Price = v }),
+		}
+
+		reader, err := NewReader(in, wrong)
+		Expect(reader).Should(BeNil())
+
+		var tErr *utils.TypeError
+		Expect(errors.As(err, &tErr)).Should(BeTrue())
+		Expect(tErr.Type).Should(Equal("Decimal"))
+		Expect(errors.Is(err, utils.ErrUnsupportedType)).Should(BeTrue())
+	})
+
+	// Tests that the reader returns an error if an enum column contains a name that is not a value of the enum
+	It("Read - Unknown enum name - Error", func() {
+		written := []Column[gopb.Trade]{
+			StringColumn("tape", func(t gopb.Trade) string { return t.ID }, nil),
+		}
+
+		in, err := os.Open(writeFile(written, gopb.Trade{ID: "Derp"}))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		reader, err := NewReader(in, []Column[gopb.Trade]{tradeColumns(9, 2)[5]})
+		Expect(err).ShouldNot(HaveOccurred())
+		defer reader.Close()
+
+		records, err := reader.Read()
+		Expect(records).Should(BeNil())
+		Expect(errors.Is(err, utils.ErrUnknownEnumName)).Should(BeTrue())
+	})
+})
+
+// Helper type that discards everything written to it and records whether it was closed
+type closeRecorder struct {
+	closed bool
+}
+
+// Write discards the data provided
+func (r *closeRecorder) Write(data []byte) (int, error) {
+	return len(data), nil
+}
+
+// Close records that the writer was closed
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
@@ -0,0 +1,15 @@
+package parquetpb
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Create a new test runner we'll use to test all the
+// modules in the parquetpb package
+func TestParquetpb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Parquetpb Suite")
+}
@@ -0,0 +1,77 @@
+package parquetpb
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/file"
+)
+
+// Reader reads records from a Parquet file, using the columns it was created with. Each column is matched
+// to the column in the file with the same name; any other columns in the file are ignored
+type Reader[T any] struct {
+	reader  *file.Reader
+	columns []Column[T]
+	indices []int
+}
+
+// NewReader creates a new Reader that reads records from the input provided, using the columns provided.
+// An error will be returned if the input is not a Parquet file, or if any of the columns are missing from
+// the file or have a type that cannot be read into their field
+func NewReader[T any](in parquet.ReaderAtSeeker, columns []Column[T]) (*Reader[T], error) {
+	reader, err := file.NewParquetReader(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find each of the columns in the file and check that it can be read into its field
+	fileSchema := reader.MetaData().Schema
+	indices := make([]int, len(columns))
+	for i, column := range columns {
+		index := fileSchema.ColumnIndexByName(column.Name())
+		if index < 0 {
+			return nil, fmt.Errorf("Parquet file does not contain a column named %q", column.Name())
+		}
+
+		if err := column.check(fileSchema.Column(index)); err != nil {
+			return nil, err
+		}
+
+		indices[i] = index
+	}
+
+	return &Reader[T]{reader: reader, columns: columns, indices: indices}, nil
+}
+
+// NumRows returns the number of records in the file
+func (r *Reader[T]) NumRows() int64 {
+	return r.reader.NumRows()
+}
+
+// Read reads all the records in the file
+func (r *Reader[T]) Read() ([]T, error) {
+	records := make([]T, 0, r.reader.NumRows())
+	for i := 0; i < r.reader.NumRowGroups(); i++ {
+		group := r.reader.RowGroup(i)
+		batch := make([]T, group.NumRows())
+		for j, column := range r.columns {
+			chunk, err := group.Column(r.indices[j])
+			if err != nil {
+				return nil, err
+			}
+
+			if err := column.read(chunk, batch); err != nil {
+				return nil, fmt.Errorf("%w for column %q", err, column.Name())
+			}
+		}
+
+		records = append(records, batch...)
+	}
+
+	return records, nil
+}
+
+// Close closes the reader. If the input is an io.Closer then it will be closed as well
+func (r *Reader[T]) Close() error {
+	return r.reader.Close()
+}
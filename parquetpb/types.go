@@ -0,0 +1,92 @@
+// Package parquetpb maps the types in the gopb package to Parquet logical types, and provides a writer and
+// reader for records containing them. This allows market data to be stored in Parquet, with each column
+// keeping its type information, rather than flattening every field to a string.
+package parquetpb
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/schema"
+	"github.com/xefino/protobuf-gen-go/utils"
+)
+
+// The maximum precision of a DECIMAL stored as an INT32, in digits
+const maxInt32Precision = 9
+
+// The maximum precision of a DECIMAL stored as an INT64, in digits
+const maxInt64Precision = 18
+
+// DecimalNode creates the Parquet schema node that a Decimal with the precision and scale provided is
+// mapped to. This will be an optional DECIMAL column, stored as an INT32 if the precision is no more than 9
+// digits, as an INT64 if it is no more than 18 digits, or as a FIXED_LEN_BYTE_ARRAY otherwise. An error
+// will be returned if the precision is less than 1, or if the scale is negative or greater than the
+// precision
+func DecimalNode(name string, precision int32, scale int32) (*schema.PrimitiveNode, error) {
+	if precision < 1 {
+		return nil, &utils.RangeError{Type: "decimal precision", Value: fmt.Sprintf("%d", precision), Bound: "1",
+			Err: utils.ErrUnderflow}
+	} else if scale < 0 {
+		return nil, &utils.RangeError{Type: "decimal scale", Value: fmt.Sprintf("%d", scale), Bound: "0",
+			Err: utils.ErrUnderflow}
+	} else if scale > precision {
+		return nil, &utils.RangeError{Type: "decimal scale", Value: fmt.Sprintf("%d", scale),
+			Bound: fmt.Sprintf("%d", precision), Err: utils.ErrOverflow}
+	}
+
+	physical, length := decimalPhysicalType(precision)
+	return schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Optional,
+		schema.NewDecimalLogicalType(precision, scale), physical, length, -1)
+}
+
+// DecimalStringNode creates the Parquet schema node that a Decimal is mapped to when it cannot be stored as
+// a DECIMAL with the precision and scale requested. This will be an optional BYTE_ARRAY column annotated
+// as UTF8, with each value written as its string representation
+func DecimalStringNode(name string) *schema.PrimitiveNode {
+	return schema.MustPrimitive(schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Optional,
+		schema.StringLogicalType{}, parquet.Types.ByteArray, -1, -1))
+}
+
+// TimestampNode creates the Parquet schema node that a UnixTimestamp is mapped to. This will be an optional
+// INT64 column annotated as TIMESTAMP(NANOS, UTC)
+func TimestampNode(name string) *schema.PrimitiveNode {
+	return schema.MustPrimitive(schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Optional,
+		schema.NewTimestampLogicalType(true, schema.TimeUnitNanos), parquet.Types.Int64, -1, -1))
+}
+
+// EnumNode creates the Parquet schema node that an enum is mapped to. This will be an optional BYTE_ARRAY
+// column annotated as ENUM, with each value written as its name. Readers that do not support the ENUM
+// annotation will treat the column as UTF8
+func EnumNode(name string) *schema.PrimitiveNode {
+	return schema.MustPrimitive(schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Optional,
+		schema.EnumLogicalType{}, parquet.Types.ByteArray, -1, -1))
+}
+
+// Helper function that determines the physical type, and length if it is a FIXED_LEN_BYTE_ARRAY, that
+// a DECIMAL with the precision provided should be stored as
+func decimalPhysicalType(precision int32) (parquet.Type, int) {
+	switch {
+	case precision <= maxInt32Precision:
+		return parquet.Types.Int32, -1
+	case precision <= maxInt64Precision:
+		return parquet.Types.Int64, -1
+	}
+
+	// Find the smallest number of bytes that can hold every value with the precision as a signed,
+	// two's complement integer
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	length := 1
+	for new(big.Int).Lsh(big.NewInt(1), uint(8*length-1)).Cmp(limit) < 0 {
+		length++
+	}
+
+	return parquet.Types.FixedLenByteArray, length
+}
+
+// Helper function that creates an error for a Parquet column that has a different type from the one
+// expected for a gopb type
+func typeError(column *schema.Column, typ string) error {
+	return &utils.TypeError{Source: fmt.Sprintf("Parquet column %q", column.Name()),
+		Actual: fmt.Sprintf("%s (%s)", column.PhysicalType(), column.LogicalType()), Type: typ}
+}
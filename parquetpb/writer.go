@@ -0,0 +1,139 @@
+package parquetpb
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/file"
+	"github.com/apache/arrow/go/v11/parquet/schema"
+)
+
+// Writer writes records to a Parquet file, with one column for each of the columns it was created with.
+// Records are buffered in memory until there are enough of them to fill a row group, at which point the
+// row group is written to the output. The number of records in each row group can be set with the
+// parquet.WithMaxRowGroupLength writer property
+type Writer[T any] struct {
+	writer  *file.Writer
+	columns []Column[T]
+	size    int
+	records []T
+	err     error
+	closed  bool
+}
+
+// NewWriter creates a new Writer that writes records to the output provided, using the columns provided.
+// Additional writer properties, such as the compression codec or the row group length, can be set with
+// opts. An error will be returned if no columns are provided, if any of the columns have the same name, or
+// if the precision or scale of a decimal column is invalid
+func NewWriter[T any](out io.Writer, columns []Column[T], opts ...parquet.WriterProperty) (*Writer[T], error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("Parquet writer requires at least one column")
+	}
+
+	// First, create the schema node for each column, verifying that the column names are unique
+	names := make(map[string]bool, len(columns))
+	fields := make(schema.FieldList, len(columns))
+	for i, column := range columns {
+		if names[column.Name()] {
+			return nil, fmt.Errorf("Parquet column %q was defined more than once", column.Name())
+		}
+
+		node, err := column.node()
+		if err != nil {
+			return nil, fmt.Errorf("%w for column %q", err, column.Name())
+		}
+
+		names[column.Name()] = true
+		fields[i] = node
+	}
+
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, fields, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Next, create the file writer, which writes the file header to the output
+	props := parquet.NewWriterProperties(opts...)
+	size := int(props.MaxRowGroupLength())
+	if size < 1 {
+		size = 1
+	}
+
+	return &Writer[T]{writer: file.NewParquetWriter(out, root, file.WithWriterProps(props)),
+		columns: columns, size: size}, nil
+}
+
+// Schema returns the schema of the file being written, which can be used to determine the Parquet type
+// each column is written with
+func (w *Writer[T]) Schema() *schema.Schema {
+	return w.writer.Schema
+}
+
+// Write adds records to the writer, writing a row group to the output each time enough records have been
+// added to fill one. If writing a row group fails then the file cannot be completed, so the error will be
+// returned from this and every later call to Write or Close
+func (w *Writer[T]) Write(records ...T) error {
+	if w.closed {
+		return fmt.Errorf("Parquet writer was already closed")
+	} else if w.err != nil {
+		return w.err
+	}
+
+	w.records = append(w.records, records...)
+	for len(w.records) >= w.size {
+		if w.err = w.flush(w.records[:w.size]); w.err != nil {
+			return w.err
+		}
+
+		w.records = append(w.records[:0], w.records[w.size:]...)
+	}
+
+	return nil
+}
+
+// Close writes any records still buffered by the writer to the output as a final row group, followed by
+// the file footer. The file writer is closed even if an error occurs, and if the output is an io.Closer
+// then it will be closed as well. Calling Close more than once returns the result of the first call
+func (w *Writer[T]) Close() error {
+	if w.closed {
+		return w.err
+	}
+
+	w.closed = true
+
+	// First, write the remaining records as a row group, unless writing an earlier row group failed
+	if w.err == nil && len(w.records) > 0 {
+		w.err = w.flush(w.records)
+	}
+
+	w.records = nil
+
+	// Finally, close the file writer so that the output is closed, keeping the first error encountered
+	if err := w.writer.Close(); w.err == nil {
+		w.err = err
+	}
+
+	return w.err
+}
+
+// Helper function that writes records to the output as a single row group
+func (w *Writer[T]) flush(records []T) error {
+	group := w.writer.AppendRowGroup()
+	for _, column := range w.columns {
+		chunk, err := group.NextColumn()
+		if err != nil {
+			return err
+		}
+
+		if err := column.write(chunk, records); err != nil {
+			return fmt.Errorf("%w for column %q", err, column.Name())
+		}
+
+		if err := chunk.Close(); err != nil {
+			return err
+		}
+	}
+
+	return group.Close()
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"sync/atomic"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// EnumBSONFormat determines whether enum values are written to BSON as strings or integers
+type EnumBSONFormat int32
+
+const (
+	// DefaultEnumBSON indicates that the global BSON format should be used
+	DefaultEnumBSON EnumBSONFormat = iota
+
+	// EnumBSONString writes enum values as BSON strings, containing the name of each value
+	EnumBSONString
+
+	// EnumBSONInt writes enum values as 32-bit BSON integers
+	EnumBSONInt
+)
+
+// The global BSON format, used by any codec that hasn't been given a BSON format of its own
+var enumBSONFormat int32 = int32(EnumBSONString)
+
+// SetEnumBSONFormat sets the format used to write enum values to BSON by any EnumCodec that hasn't been
+// given a format of its own. Setting the format to DefaultEnumBSON will restore the string format
+func SetEnumBSONFormat(format EnumBSONFormat) {
+	if format == DefaultEnumBSON {
+		format = EnumBSONString
+	}
+
+	atomic.StoreInt32(&enumBSONFormat, int32(format))
+}
+
+// Helper function that resolves the BSON format provided to the global BSON format if it is
+// DefaultEnumBSON
+func resolveBSONFormat(format EnumBSONFormat) EnumBSONFormat {
+	if format == DefaultEnumBSON {
+		return EnumBSONFormat(atomic.LoadInt32(&enumBSONFormat))
+	}
+
+	return format
+}
+
+// NewBSONTypeError creates a new TypeError for a BSON value, of the type provided, that could not be
+// converted to a type
+func NewBSONTypeError(actual bsontype.Type, typ string) *TypeError {
+	return &TypeError{Source: "BSON value", Actual: actual.String(), Type: typ}
+}
@@ -0,0 +1,429 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// ProtoEnum describes the protobuf-generated enum types that can be handled by an EnumCodec
+type ProtoEnum interface {
+	~int32
+	protoreflect.Enum
+}
+
+// EnumCodec converts a protobuf enum to and from JSON, CSV, text, YAML, XML, BSON, DynamoDB and SQL. The
+// names and values of the enum are read from its protobuf descriptor, and may be supplemented with alternate names
+// that will be accepted when decoding and a mapping of output names that will be used in place of the
+// protobuf names when encoding. When decoding, names that do not match exactly will be normalized and
+// compared against the normalized names and alternate names of the enum. Integers will be validated
+// against the values in the descriptor if the codec's decoding mode is strict
+type EnumCodec[T ProtoEnum] struct {
+	alternates   map[string]T
+	mapping      map[T]string
+	descriptions map[T]string
+	csv          func(T) string
+	sql          func(T) driver.Value
+	normalizer   Normalizer
+	mode         DecodingMode
+	bsonFormat   EnumBSONFormat
+	allowed      map[string]bool
+	once         sync.Once
+	name         string
+	names        map[int32]string
+	values       map[string]int32
+	order        []T
+	aliases      map[T][]string
+	index        *NameIndex[T]
+}
+
+// The codecs that have been created, keyed by the type of the enum they convert
+var codecRegistry sync.Map
+
+// NewEnumCodec creates a new EnumCodec for an enum from its alternate names and output names, either of
+// which may be nil. The codec will read the enum's descriptor the first time it is used, so it may be
+// created before the protobuf file descriptors have been initialized. The first codec created for an
+// enum will be used by Values, Names, Describe and Enumerate
+func NewEnumCodec[T ProtoEnum](alternates map[string]T, mapping map[T]string) *EnumCodec[T] {
+	codec := &EnumCodec[T]{alternates: alternates, mapping: mapping, normalizer: DefaultNormalizer}
+	codecRegistry.LoadOrStore(reflect.TypeOf(T(0)), codec)
+	return codec
+}
+
+// WithCSV sets the function used to convert an enum value to a CSV cell value. By default, the value
+// will be written as its name
+func (codec *EnumCodec[T]) WithCSV(format func(T) string) *EnumCodec[T] {
+	codec.csv = format
+	return codec
+}
+
+// WithSQL sets the function used to convert an enum value to an SQL value. By default, the value
+// will be written as its name
+func (codec *EnumCodec[T]) WithSQL(format func(T) driver.Value) *EnumCodec[T] {
+	codec.sql = format
+	return codec
+}
+
+// WithDescriptions sets the human-readable descriptions of the values of the enum
+func (codec *EnumCodec[T]) WithDescriptions(descriptions map[T]string) *EnumCodec[T] {
+	codec.descriptions = descriptions
+	return codec
+}
+
+// WithNormalizer sets the normalizer used to match names that do not match exactly when decoding. By
+// default, DefaultNormalizer will be used. If the normalizer is nil then names must match exactly
+func (codec *EnumCodec[T]) WithNormalizer(normalizer Normalizer) *EnumCodec[T] {
+	codec.normalizer = normalizer
+	return codec
+}
+
+// WithDecodingMode sets the decoding mode used by the codec. By default, the codec will use the global
+// decoding mode, which may be changed with SetDecodingMode
+func (codec *EnumCodec[T]) WithDecodingMode(mode DecodingMode) *EnumCodec[T] {
+	codec.mode = mode
+	return codec
+}
+
+// WithBSONFormat sets the format used to write the enum to BSON. By default, the codec will use the
+// global BSON format, which may be changed with SetEnumBSONFormat
+func (codec *EnumCodec[T]) WithBSONFormat(format EnumBSONFormat) *EnumCodec[T] {
+	codec.bsonFormat = format
+	return codec
+}
+
+// WithAllowedCollisions sets the normal forms that are expected to be produced by names referring to
+// different values, such as codes that differ only in case. These normal forms will still only be
+// matched exactly, but will not be reported by Validate
+func (codec *EnumCodec[T]) WithAllowedCollisions(forms ...string) *EnumCodec[T] {
+	codec.allowed = make(map[string]bool, len(forms))
+	for _, form := range forms {
+		codec.allowed[form] = true
+	}
+
+	return codec
+}
+
+// FormatNumber converts an enum value to its integer value, as a string. This function may be used
+// with WithCSV to write an enum's integer value to CSV instead of its name
+func FormatNumber[T ~int32](value T) string {
+	return strconv.FormatInt(int64(value), 10)
+}
+
+// NumberValue converts an enum value to its integer value, as an SQL value. This function may be used
+// with WithSQL to write an enum's integer value to SQL instead of its name
+func NumberValue[T ~int32](value T) driver.Value {
+	return driver.Value(int64(value))
+}
+
+// Name returns the name of the enum type, as declared in its protobuf file
+func (codec *EnumCodec[T]) Name() string {
+	codec.init()
+	return codec.name
+}
+
+// Collisions returns an error for each set of names and alternate names of the enum which refer to
+// different values but have the same normal form. These names will only be matched exactly
+func (codec *EnumCodec[T]) Collisions() []error {
+	codec.init()
+	return codec.index.Collisions()
+}
+
+// Validate reads the enum's descriptor and indexes its names and alternate names, returning an error if
+// any of them refer to different values but have the same normal form, unless that normal form was
+// allowed with WithAllowedCollisions. Since the index is built only once, this should be called after
+// every alternate name has been added, typically when the package declaring the codec is initialized
+func (codec *EnumCodec[T]) Validate() error {
+	codec.init()
+	var collisions []string
+	for _, key := range codec.index.Ambiguous() {
+		if !codec.allowed[key] {
+			collisions = append(collisions, codec.index.collision(key).Error())
+		}
+	}
+
+	if len(collisions) > 0 {
+		return fmt.Errorf("enum %s has names that collide after normalization: %s", codec.name,
+			strings.Join(collisions, "; "))
+	}
+
+	return nil
+}
+
+// Values returns every value defined for the enum, in the order they were declared
+func (codec *EnumCodec[T]) Values() []T {
+	codec.init()
+	values := make([]T, len(codec.order))
+	copy(values, codec.order)
+	return values
+}
+
+// Names returns the protobuf names of every value defined for the enum, in the order they were declared
+func (codec *EnumCodec[T]) Names() []string {
+	codec.init()
+	names := make([]string, len(codec.order))
+	for i, value := range codec.order {
+		names[i] = codec.names[int32(value)]
+	}
+
+	return names
+}
+
+// Describe returns the metadata associated with an enum value. If the value is not defined for the enum
+// then only its value and output name will be set. The aliases are copied so they may be modified by the
+// caller without affecting the codec
+func (codec *EnumCodec[T]) Describe(value T) EnumValue[T] {
+	codec.init()
+	var aliases []string
+	if existing := codec.aliases[value]; len(existing) > 0 {
+		aliases = append(make([]string, 0, len(existing)), existing...)
+	}
+
+	return EnumValue[T]{
+		Value:       value,
+		Name:        codec.names[int32(value)],
+		OutputName:  codec.String(value),
+		Aliases:     aliases,
+		Description: codec.descriptions[value],
+	}
+}
+
+// String converts an enum value to its output name, if one exists, or its protobuf name otherwise. If
+// the value has neither then it will be written as an integer
+func (codec *EnumCodec[T]) String(value T) string {
+	codec.init()
+	return MarshalString(value, codec.names, codec.mapping, false)
+}
+
+// EncodeJSON converts an enum value to a JSON string
+func (codec *EnumCodec[T]) EncodeJSON(value T) ([]byte, error) {
+	codec.init()
+	return []byte(MarshalString(value, codec.names, codec.mapping, true)), nil
+}
+
+// EncodeCSV converts an enum value to a CSV cell value
+func (codec *EnumCodec[T]) EncodeCSV(value T) (string, error) {
+	if codec.csv != nil {
+		return codec.csv(value), nil
+	}
+
+	return codec.String(value), nil
+}
+
+// EncodeText converts an enum value to text, in the same format as EncodeCSV
+func (codec *EnumCodec[T]) EncodeText(value T) ([]byte, error) {
+	text, err := codec.EncodeCSV(value)
+	return []byte(text), err
+}
+
+// EncodeYAML converts an enum value to a YAML node value
+func (codec *EnumCodec[T]) EncodeYAML(value T) (interface{}, error) {
+	return codec.String(value), nil
+}
+
+// EncodeXML writes an enum value to XML as an element containing its name
+func (codec *EnumCodec[T]) EncodeXML(value T, encoder *xml.Encoder, start xml.StartElement) error {
+	return encoder.EncodeElement(codec.String(value), start)
+}
+
+// EncodeXMLAttr converts an enum value to an XML attribute containing its name
+func (codec *EnumCodec[T]) EncodeXMLAttr(value T, name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: codec.String(value)}, nil
+}
+
+// EncodeBSON converts an enum value to a BSON value. Depending on the codec's BSON format, the value will
+// be written as a string containing its name or as a 32-bit integer
+func (codec *EnumCodec[T]) EncodeBSON(value T) (bsontype.Type, []byte, error) {
+	if resolveBSONFormat(codec.bsonFormat) == EnumBSONInt {
+		return bson.MarshalValue(int32(value))
+	}
+
+	return bson.MarshalValue(codec.String(value))
+}
+
+// EncodeDynamoDB converts an enum value to a DynamoDB AttributeValue
+func (codec *EnumCodec[T]) EncodeDynamoDB(value T) (types.AttributeValue, error) {
+	return &types.AttributeValueMemberS{Value: codec.String(value)}, nil
+}
+
+// EncodeSQL converts an enum value to an SQL driver value
+func (codec *EnumCodec[T]) EncodeSQL(value T) (driver.Value, error) {
+	if codec.sql != nil {
+		return codec.sql(value), nil
+	}
+
+	return driver.Value(codec.String(value)), nil
+}
+
+// DecodeJSON attempts to convert a JSON value to an enum value
+func (codec *EnumCodec[T]) DecodeJSON(raw []byte, data *T) error {
+	asStr, err := unquote(raw)
+	if err != nil {
+		return err
+	}
+
+	return codec.DecodeCSV(asStr, data)
+}
+
+// DecodeCSV attempts to convert a CSV cell value to an enum value
+func (codec *EnumCodec[T]) DecodeCSV(raw string, data *T) error {
+	codec.init()
+	if err := unmarshalString(raw, codec.values, codec.alternates, data, codec.validator()); err != nil {
+		return codec.lookup(raw, data, err)
+	}
+
+	return nil
+}
+
+// DecodeText attempts to convert text to an enum value, in the same format as DecodeCSV
+func (codec *EnumCodec[T]) DecodeText(raw []byte, data *T) error {
+	return codec.DecodeCSV(string(raw), data)
+}
+
+// DecodeYAML attempts to convert a YAML node to an enum value
+func (codec *EnumCodec[T]) DecodeYAML(value *yaml.Node, data *T) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("YAML node had an invalid kind (expected scalar value)")
+	}
+
+	return codec.DecodeCSV(value.Value, data)
+}
+
+// DecodeXML attempts to convert the content of an XML element to an enum value
+func (codec *EnumCodec[T]) DecodeXML(decoder *xml.Decoder, start xml.StartElement, data *T) error {
+	var raw string
+	if err := decoder.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	return codec.DecodeCSV(raw, data)
+}
+
+// DecodeXMLAttr attempts to convert an XML attribute to an enum value
+func (codec *EnumCodec[T]) DecodeXMLAttr(attr xml.Attr, data *T) error {
+	return codec.DecodeCSV(attr.Value, data)
+}
+
+// DecodeBSON attempts to convert a BSON value to an enum value. This function can handle strings and
+// integers, whatever the codec's BSON format. If the BSON value is null then the enum value will not be
+// modified
+func (codec *EnumCodec[T]) DecodeBSON(typ bsontype.Type, raw []byte, data *T) error {
+	value := bson.RawValue{Type: typ, Value: raw}
+	switch typ {
+	case bsontype.String:
+		return codec.DecodeCSV(value.StringValue(), data)
+	case bsontype.Int32:
+		return codec.DecodeCSV(strconv.FormatInt(int64(value.Int32()), 10), data)
+	case bsontype.Int64:
+		return codec.DecodeCSV(strconv.FormatInt(value.Int64(), 10), data)
+	case bsontype.Null:
+		return nil
+	default:
+		return NewBSONTypeError(typ, fmt.Sprintf("%T", *data))
+	}
+}
+
+// DecodeDynamoDB attempts to convert a DynamoDB AttributeValue to an enum value. This function can
+// handle []bytes, numerics, or strings. If the AttributeValue is NULL then the enum value will not be
+// modified
+func (codec *EnumCodec[T]) DecodeDynamoDB(value types.AttributeValue, data *T) error {
+	switch casted := value.(type) {
+	case *types.AttributeValueMemberB:
+		return codec.DecodeJSON(casted.Value, data)
+	case *types.AttributeValueMemberN:
+		return codec.DecodeCSV(casted.Value, data)
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberS:
+		return codec.DecodeCSV(casted.Value, data)
+	default:
+		return NewTypeError("Attribute value", value, fmt.Sprintf("%T", *data))
+	}
+}
+
+// DecodeSQL attempts to convert an SQL driver value to an enum value
+func (codec *EnumCodec[T]) DecodeSQL(value interface{}, data *T) error {
+	codec.init()
+	err := scanValue(value, codec.values, codec.alternates, data, codec.validator())
+	if asStr, ok := value.(string); ok && err != nil {
+		return codec.lookup(asStr, data, err)
+	}
+
+	return err
+}
+
+// Helper function that attempts to find the value associated with the normal form of a name, after it
+// failed to match exactly. If no value could be found then the original error will be returned
+func (codec *EnumCodec[T]) lookup(name string, data *T, original error) error {
+	value, ok, err := codec.index.Lookup(name)
+	if err != nil {
+		return err
+	} else if !ok {
+		return original
+	}
+
+	*data = value
+	return nil
+}
+
+// Helper function that creates a function to check whether an integer is defined in the enum's
+// descriptor. If the codec isn't decoding strictly then this function returns nil
+func (codec *EnumCodec[T]) validator() func(int32) bool {
+	if !IsStrict(codec.mode) {
+		return nil
+	}
+
+	return func(value int32) bool {
+		_, ok := codec.names[value]
+		return ok
+	}
+}
+
+// Helper function that reads the names and values of the enum from its descriptor
+func (codec *EnumCodec[T]) init() {
+	codec.once.Do(func() {
+		var zero T
+		desc := zero.Descriptor()
+		values := desc.Values()
+		codec.names = make(map[int32]string, values.Len())
+		codec.values = make(map[string]int32, values.Len())
+		codec.order = make([]T, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			value := values.Get(i)
+			codec.names[int32(value.Number())] = string(value.Name())
+			codec.values[string(value.Name())] = int32(value.Number())
+			codec.order[i] = T(value.Number())
+		}
+
+		// Collect the alternate names of each value, sorted so they are always returned in the same order
+		codec.aliases = make(map[T][]string)
+		for alias, value := range codec.alternates {
+			codec.aliases[value] = append(codec.aliases[value], alias)
+		}
+
+		for _, aliases := range codec.aliases {
+			sort.Strings(aliases)
+		}
+
+		// Index the names and alternate names of the enum by their normal forms
+		names := make(map[string]T, len(codec.values))
+		for name, value := range codec.values {
+			names[name] = T(value)
+		}
+
+		codec.index = NewNameIndex(codec.normalizer, names, codec.alternates)
+
+		// The name of the enum is its full name, without the package prefix
+		codec.name = strings.TrimPrefix(string(desc.FullName()), string(desc.ParentFile().Package())+".")
+	})
+}
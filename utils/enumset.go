@@ -0,0 +1,621 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// EnumSetSeparator is the separator placed between the values of an EnumSet when it is written to CSV
+var EnumSetSeparator = "|"
+
+// MaxEnumSetValue is the largest value that can be stored in an EnumSet whose type is not a protobuf enum.
+// Protobuf enums are instead limited to the values defined in their descriptor. This bounds the size of
+// the bitset when a set is decoded from untrusted input
+const MaxEnumSetValue = 4095
+
+// EnumSet is a set of enum values, backed by a bitset, which is intended to hold multi-valued fields such
+// as the conditions attached to a trade. Only non-negative values that are defined for the enum can be
+// stored in the set. The zero value is an empty set that is ready to use. Sets behave as values: Add and
+// Remove copy the bitset before modifying it, so modifying a copy of a set never affects the original.
+// When converting the set to and from JSON, CSV, YAML, DynamoDB and SQL, each value is converted with its
+// own marshaller or unmarshaller, if it has one, so that the names used match those used for a single value
+type EnumSet[T ~int32] struct {
+	words []uint64
+}
+
+// NewEnumSet creates a new EnumSet containing the values provided. Values that cannot be stored in the set,
+// because they are negative or are not defined for the enum, are ignored; use Add to detect them instead
+func NewEnumSet[T ~int32](values ...T) EnumSet[T] {
+	var set EnumSet[T]
+	for _, value := range values {
+		_ = set.Add(value)
+	}
+
+	return set
+}
+
+// Add adds values to the set. If any of the values is negative, or is not defined for the enum, then an
+// error will be returned and the set will not be modified. For types that are not protobuf enums, values
+// greater than MaxEnumSetValue will also be rejected
+func (set *EnumSet[T]) Add(values ...T) error {
+	for _, value := range values {
+		if err := checkElement(value); err != nil {
+			return err
+		}
+	}
+
+	// Copy the bitset before modifying it, as it may be shared with a copy of the set
+	length := len(set.words)
+	for _, value := range values {
+		if word := int(value)/64 + 1; word > length {
+			length = word
+		}
+	}
+
+	words := make([]uint64, length)
+	copy(words, set.words)
+	for _, value := range values {
+		words[int(value)/64] |= 1 << (uint(value) % 64)
+	}
+
+	set.words = words
+	return nil
+}
+
+// Remove removes values from the set
+func (set *EnumSet[T]) Remove(values ...T) {
+
+	// Copy the bitset before modifying it, as it may be shared with a copy of the set
+	words := make([]uint64, len(set.words))
+	copy(words, set.words)
+	for _, value := range values {
+		if value < 0 {
+			continue
+		}
+
+		if word, bit := int(value)/64, uint(value)%64; word < len(words) {
+			words[word] &^= 1 << bit
+		}
+	}
+
+	set.words = words
+}
+
+// Clear removes all values from the set
+func (set *EnumSet[T]) Clear() {
+	set.words = nil
+}
+
+// Has returns true if the set contains the value, or false otherwise
+func (set EnumSet[T]) Has(value T) bool {
+	if value < 0 {
+		return false
+	}
+
+	word, bit := int(value)/64, uint(value)%64
+	return word < len(set.words) && set.words[word]&(1<<bit) != 0
+}
+
+// HasAny returns true if the set contains at least one of the values in the other set
+func (set EnumSet[T]) HasAny(other EnumSet[T]) bool {
+	for i := 0; i < len(set.words) && i < len(other.words); i++ {
+		if set.words[i]&other.words[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasAll returns true if the set contains every value in the other set
+func (set EnumSet[T]) HasAll(other EnumSet[T]) bool {
+	for i, word := range other.words {
+		var mine uint64
+		if i < len(set.words) {
+			mine = set.words[i]
+		}
+
+		if word&^mine != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Union creates a new set containing the values in either set
+func (set EnumSet[T]) Union(other EnumSet[T]) EnumSet[T] {
+	longer, shorter := set.words, other.words
+	if len(shorter) > len(longer) {
+		longer, shorter = shorter, longer
+	}
+
+	words := make([]uint64, len(longer))
+	copy(words, longer)
+	for i, word := range shorter {
+		words[i] |= word
+	}
+
+	return EnumSet[T]{words: words}
+}
+
+// Intersect creates a new set containing the values in both sets
+func (set EnumSet[T]) Intersect(other EnumSet[T]) EnumSet[T] {
+	size := len(set.words)
+	if len(other.words) < size {
+		size = len(other.words)
+	}
+
+	words := make([]uint64, size)
+	for i := range words {
+		words[i] = set.words[i] & other.words[i]
+	}
+
+	return EnumSet[T]{words: words}
+}
+
+// Difference creates a new set containing the values in this set that are not in the other set
+func (set EnumSet[T]) Difference(other EnumSet[T]) EnumSet[T] {
+	words := make([]uint64, len(set.words))
+	for i, word := range set.words {
+		if i < len(other.words) {
+			word &^= other.words[i]
+		}
+
+		words[i] = word
+	}
+
+	return EnumSet[T]{words: words}
+}
+
+// Equals returns true if both sets contain the same values, or false otherwise
+func (set EnumSet[T]) Equals(other EnumSet[T]) bool {
+	return set.HasAll(other) && other.HasAll(set)
+}
+
+// Len returns the number of values in the set
+func (set EnumSet[T]) Len() int {
+	var count int
+	for _, word := range set.words {
+		count += bits.OnesCount64(word)
+	}
+
+	return count
+}
+
+// IsEmpty returns true if the set contains no values, or false otherwise
+func (set EnumSet[T]) IsEmpty() bool {
+	for _, word := range set.words {
+		if word != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clone creates a copy of the set that can be modified independently of the original
+func (set EnumSet[T]) Clone() EnumSet[T] {
+	words := make([]uint64, len(set.words))
+	copy(words, set.words)
+	return EnumSet[T]{words: words}
+}
+
+// Range calls the function provided with each value in the set, in ascending order, until the function
+// returns false
+func (set EnumSet[T]) Range(f func(T) bool) {
+	for i, word := range set.words {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			if !f(T(i*64 + bit)) {
+				return
+			}
+
+			word &= word - 1
+		}
+	}
+}
+
+// Values returns the values in the set, in ascending order
+func (set EnumSet[T]) Values() []T {
+	values := make([]T, 0, set.Len())
+	set.Range(func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+// Bitmask converts the set to a 64-bit mask, where each value is represented by the bit at its position.
+// This function returns false if the set contains a value that is too large to be represented
+func (set EnumSet[T]) Bitmask() (uint64, bool) {
+	var mask uint64
+	for i, word := range set.words {
+		if i == 0 {
+			mask = word
+		} else if word != 0 {
+			return 0, false
+		}
+	}
+
+	return mask, true
+}
+
+// String converts the set to a string, containing the names of its values separated by commas
+func (set EnumSet[T]) String() string {
+	values := set.Values()
+	names := make([]string, len(values))
+	for i, value := range values {
+		names[i] = formatElement(value)
+	}
+
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
+// MarshalJSON converts the set to a JSON array containing the JSON value of each value in the set
+func (set EnumSet[T]) MarshalJSON() ([]byte, error) {
+	values := set.Values()
+	elements := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		raw, err := marshalElementJSON(value)
+		if err != nil {
+			return nil, err
+		}
+
+		elements[i] = raw
+	}
+
+	return json.Marshal(elements)
+}
+
+// MarshalCSV converts the set to a CSV cell value, containing the CSV value of each value in the set
+// separated by EnumSetSeparator
+func (set EnumSet[T]) MarshalCSV() (string, error) {
+	values := set.Values()
+	parts := make([]string, len(values))
+	for i, value := range values {
+		part, err := marshalElementCSV(value)
+		if err != nil {
+			return "", err
+		}
+
+		parts[i] = part
+	}
+
+	return strings.Join(parts, EnumSetSeparator), nil
+}
+
+// MarshalYAML converts the set to a YAML sequence containing the YAML value of each value in the set
+func (set EnumSet[T]) MarshalYAML() (interface{}, error) {
+	values := set.Values()
+	elements := make([]interface{}, len(values))
+	for i, value := range values {
+		element, err := marshalElementYAML(value)
+		if err != nil {
+			return nil, err
+		}
+
+		elements[i] = element
+	}
+
+	return elements, nil
+}
+
+// MarshalDynamoDBAttributeValue converts the set to a DynamoDB string set, containing the DynamoDB value
+// of each value in the set. If the enum is written to DynamoDB as a number then a number set will be
+// produced instead. As DynamoDB doesn't allow empty sets, an empty set will be written as NULL
+func (set EnumSet[T]) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	values := set.Values()
+	if len(values) == 0 {
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	}
+
+	members := make([]string, len(values))
+	numeric := true
+	for i, value := range values {
+		member, isNumber, err := marshalElementDynamoDB(value)
+		if err != nil {
+			return nil, err
+		}
+
+		members[i] = member
+		numeric = numeric && isNumber
+	}
+
+	if numeric {
+		return &types.AttributeValueMemberNS{Value: members}, nil
+	}
+
+	return &types.AttributeValueMemberSS{Value: members}, nil
+}
+
+// Value converts the set to an SQL value, as an integer array literal containing the values in the set
+func (set EnumSet[T]) Value() (driver.Value, error) {
+	values := set.Values()
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.FormatInt(int64(value), 10)
+	}
+
+	return driver.Value("{" + strings.Join(parts, ",") + "}"), nil
+}
+
+// UnmarshalJSON converts a JSON array into a set, using the JSON unmarshaller of the enum to convert each
+// element. A JSON null will produce an empty set
+func (set *EnumSet[T]) UnmarshalJSON(raw []byte) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(raw, &elements); err != nil {
+		return err
+	}
+
+	values := make([]T, len(elements))
+	for i, element := range elements {
+		if err := unmarshalElementJSON(element, &values[i]); err != nil {
+			return err
+		}
+	}
+
+	return set.replace(values)
+}
+
+// UnmarshalCSV converts a CSV cell value, containing values separated by EnumSetSeparator, into a set,
+// using the CSV unmarshaller of the enum to convert each value
+func (set *EnumSet[T]) UnmarshalCSV(raw string) error {
+	values, err := unmarshalElements[T](splitElements(raw, EnumSetSeparator))
+	if err != nil {
+		return err
+	}
+
+	return set.replace(values)
+}
+
+// UnmarshalYAML converts a YAML sequence into a set, using the YAML unmarshaller of the enum to convert
+// each element. A scalar value will be treated as a CSV cell value, with values separated by
+// EnumSetSeparator
+func (set *EnumSet[T]) UnmarshalYAML(value *yaml.Node) error {
+	var values []T
+	switch value.Kind {
+	case yaml.SequenceNode:
+		values = make([]T, len(value.Content))
+		for i, element := range value.Content {
+			if err := unmarshalElementYAML(element, &values[i]); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		return set.UnmarshalCSV(value.Value)
+	default:
+		return fmt.Errorf("YAML node had an invalid kind (expected sequence or scalar value)")
+	}
+
+	return set.replace(values)
+}
+
+// UnmarshalDynamoDBAttributeValue converts a DynamoDB string set, number set or list into a set. If the
+// attribute value is NULL then the set will be empty
+func (set *EnumSet[T]) UnmarshalDynamoDBAttributeValue(value types.AttributeValue) error {
+	var parts []string
+	switch casted := value.(type) {
+	case *types.AttributeValueMemberSS:
+		parts = casted.Value
+	case *types.AttributeValueMemberNS:
+		parts = casted.Value
+	case *types.AttributeValueMemberL:
+		parts = make([]string, len(casted.Value))
+		for i, member := range casted.Value {
+			switch inner := member.(type) {
+			case *types.AttributeValueMemberS:
+				parts[i] = inner.Value
+			case *types.AttributeValueMemberN:
+				parts[i] = inner.Value
+			default:
+				var zero T
+				return NewTypeError("Attribute value", member, fmt.Sprintf("%T", zero))
+			}
+		}
+	case *types.AttributeValueMemberNULL:
+	default:
+		var zero T
+		return NewTypeError("Attribute value", value, fmt.Sprintf("EnumSet[%T]", zero))
+	}
+
+	values, err := unmarshalElements[T](parts)
+	if err != nil {
+		return err
+	}
+
+	return set.replace(values)
+}
+
+// Scan converts an SQL value into a set. The value may be an integer array literal, such as {1,2,3}, or
+// an integer bitmask where each value is represented by the bit at its position. If the value is nil then
+// the set will be empty
+func (set *EnumSet[T]) Scan(value interface{}) error {
+	var values []T
+	switch casted := value.(type) {
+	case nil:
+	case int64:
+		for bit := 0; bit < 64; bit++ {
+			if uint64(casted)&(1<<bit) != 0 {
+				values = append(values, T(bit))
+			}
+		}
+	case []byte:
+		return set.Scan(string(casted))
+	case string:
+		trimmed := strings.TrimSpace(casted)
+		if len(trimmed) < 2 || trimmed[0] != '{' || trimmed[len(trimmed)-1] != '}' {
+			var zero T
+			return &ParseError{Type: fmt.Sprintf("EnumSet[%T]", zero), Input: casted, Position: 0,
+				Err: fmt.Errorf("array literal must be enclosed in braces")}
+		}
+
+		parsed, err := unmarshalElements[T](splitElements(trimmed[1:len(trimmed)-1], ","))
+		if err != nil {
+			return err
+		}
+
+		values = parsed
+	default:
+		var zero T
+		return NewTypeError("Driver value", value, fmt.Sprintf("EnumSet[%T]", zero))
+	}
+
+	return set.replace(values)
+}
+
+// Helper function that replaces the contents of the set with the values provided. If any of the values
+// cannot be stored in the set then an error will be returned and the set will not be modified
+func (set *EnumSet[T]) replace(values []T) error {
+	var replaced EnumSet[T]
+	if err := replaced.Add(values...); err != nil {
+		return err
+	}
+
+	*set = replaced
+	return nil
+}
+
+// Helper function that verifies that a value can be stored in an EnumSet. Negative values are rejected
+// with a RangeError. If the enum is a protobuf enum then the value must be defined in its descriptor,
+// otherwise an EnumError wrapping ErrUnknownEnumValue is returned. Other types are limited to
+// MaxEnumSetValue so that the set cannot grow without bound
+func checkElement[T ~int32](value T) error {
+	if value < 0 {
+		return &RangeError{Type: fmt.Sprintf("%T", value), Value: strconv.FormatInt(int64(value), 10),
+			Bound: "0", Err: ErrUnderflow}
+	}
+
+	if enum, ok := any(value).(protoreflect.Enum); ok {
+		if enum.Descriptor().Values().ByNumber(protoreflect.EnumNumber(value)) == nil {
+			return &EnumError{Enum: fmt.Sprintf("%T", value), Input: strconv.FormatInt(int64(value), 10),
+				Err: ErrUnknownEnumValue}
+		}
+	} else if value > MaxEnumSetValue {
+		return &RangeError{Type: fmt.Sprintf("%T", value), Value: strconv.FormatInt(int64(value), 10),
+			Bound: strconv.Itoa(MaxEnumSetValue), Err: ErrOverflow}
+	}
+
+	return nil
+}
+
+// Helper function that splits a string into its non-empty, trimmed parts
+func splitElements(raw string, separator string) []string {
+	var parts []string
+	for _, part := range strings.Split(raw, separator) {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			parts = append(parts, trimmed)
+		}
+	}
+
+	return parts
+}
+
+// Helper function that converts a list of strings to enum values, using the CSV unmarshaller of the
+// enum if it has one, or parsing each as an integer otherwise
+func unmarshalElements[T ~int32](parts []string) ([]T, error) {
+	values := make([]T, len(parts))
+	for i, part := range parts {
+		if unmarshaller, ok := any(&values[i]).(interface{ UnmarshalCSV(string) error }); ok {
+			if err := unmarshaller.UnmarshalCSV(part); err != nil {
+				return nil, err
+			}
+		} else if err := UnmarshalString(part, None, None, &values[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// Helper function that converts a JSON value to an enum value, using the JSON unmarshaller of the enum
+// if it has one, or parsing the value as an integer otherwise
+func unmarshalElementJSON[T ~int32](raw json.RawMessage, value *T) error {
+	if unmarshaller, ok := any(value).(json.Unmarshaler); ok {
+		return unmarshaller.UnmarshalJSON(raw)
+	}
+
+	return UnmarshalValue(raw, None, None, value)
+}
+
+// Helper function that converts an enum value to JSON, using the JSON marshaller of the enum if it has
+// one, or writing it as an integer otherwise
+func marshalElementJSON[T ~int32](value T) (json.RawMessage, error) {
+	if marshaller, ok := any(value).(json.Marshaler); ok {
+		return marshaller.MarshalJSON()
+	}
+
+	return json.RawMessage(strconv.FormatInt(int64(value), 10)), nil
+}
+
+// Helper function that converts an enum value to a YAML node value, using the YAML marshaller of the enum
+// if it has one, or writing it as an integer otherwise
+func marshalElementYAML[T ~int32](value T) (interface{}, error) {
+	if marshaller, ok := any(value).(yaml.Marshaler); ok {
+		return marshaller.MarshalYAML()
+	}
+
+	return int64(value), nil
+}
+
+// Helper function that converts a YAML node to an enum value, using the YAML unmarshaller of the enum
+// if it has one, or parsing the value as an integer otherwise
+func unmarshalElementYAML[T ~int32](node *yaml.Node, value *T) error {
+	if unmarshaller, ok := any(value).(yaml.Unmarshaler); ok {
+		return unmarshaller.UnmarshalYAML(node)
+	}
+
+	return UnmarshalString(node.Value, None, None, value)
+}
+
+// Helper function that converts an enum value to a CSV cell value, using the CSV marshaller of the enum
+// if it has one, or writing it as an integer otherwise
+func marshalElementCSV[T ~int32](value T) (string, error) {
+	if marshaller, ok := any(value).(interface{ MarshalCSV() (string, error) }); ok {
+		return marshaller.MarshalCSV()
+	}
+
+	return strconv.FormatInt(int64(value), 10), nil
+}
+
+// Helper function that converts an enum value to the string stored in a DynamoDB set, using the DynamoDB
+// marshaller of the enum if it has one, or writing it as an integer otherwise. This function also returns
+// true if the value was written as a number
+func marshalElementDynamoDB[T ~int32](value T) (string, bool, error) {
+	if marshaller, ok := any(value).(interface {
+		MarshalDynamoDBAttributeValue() (types.AttributeValue, error)
+	}); ok {
+		attr, err := marshaller.MarshalDynamoDBAttributeValue()
+		if err != nil {
+			return "", false, err
+		}
+
+		switch casted := attr.(type) {
+		case *types.AttributeValueMemberS:
+			return casted.Value, false, nil
+		case *types.AttributeValueMemberN:
+			return casted.Value, true, nil
+		}
+	}
+
+	return strconv.FormatInt(int64(value), 10), true, nil
+}
+
+// Helper function that converts an enum value to a string, using its String function if it has one, or
+// writing it as an integer otherwise
+func formatElement[T ~int32](value T) string {
+	if stringer, ok := any(value).(fmt.Stringer); ok {
+		return stringer.String()
+	}
+
+	return strconv.FormatInt(int64(value), 10)
+}
@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownEnumName is returned when a name cannot be mapped to any value of an enum
+var ErrUnknownEnumName = errors.New("unknown enum name")
+
+// ErrUnknownEnumValue is returned when an integer is not one of the values defined for an enum. This is
+// only checked when decoding strictly, or when the integer cannot be represented by an enum at all
+var ErrUnknownEnumValue = errors.New("unknown enum value")
+
+// ErrAmbiguousEnumName is returned when a name does not match any name of an enum exactly, and its normal
+// form matches the names of more than one value
+var ErrAmbiguousEnumName = errors.New("ambiguous enum name")
+
+// ErrMalformed is matched by every ParseError, and indicates that the input was not in the expected format
+var ErrMalformed = errors.New("malformed input")
+
+// ErrTooShort is returned when the input was not long enough to be parsed
+var ErrTooShort = errors.New("input is too short")
+
+// ErrUnderflow is returned when a value is less than the minimum its type allows
+var ErrUnderflow = errors.New("value underflow")
+
+// ErrOverflow is returned when a value is greater than the maximum its type allows
+var ErrOverflow = errors.New("value overflow")
+
+// ErrSignMismatch is returned when the parts of a value that must share a sign, such as the seconds and
+// nanoseconds of a duration, have different signs
+var ErrSignMismatch = errors.New("sign mismatch")
+
+// ErrPrecisionLoss is returned when a value cannot be written to a format without losing precision
+var ErrPrecisionLoss = errors.New("precision loss")
+
+// ErrNil is returned when a nil value is checked for validity
+var ErrNil = errors.New("invalid nil")
+
+// ErrUnsupportedType is returned when a DynamoDB attribute value, BSON value or SQL driver value has a type
+// that cannot be converted to the requested type
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// ParseError describes input that could not be parsed to a value of some type. The position is the
+// offset, in bytes, of the part of the input that could not be parsed, or -1 if this isn't known. The
+// error wraps the cause of the failure, if there was one, and will always match ErrMalformed
+type ParseError struct {
+	Type     string
+	Input    string
+	Position int
+	Err      error
+}
+
+// Error converts the parse error to a string
+func (err *ParseError) Error() string {
+	if err.Position >= 0 {
+		return fmt.Sprintf("value of %q could not be parsed as a %s at position %d: %v",
+			err.Input, err.Type, err.Position, err.Err)
+	}
+
+	return fmt.Sprintf("value of %q could not be parsed as a %s: %v", err.Input, err.Type, err.Err)
+}
+
+// Unwrap returns the cause of the parse error
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Is returns true if the target is ErrMalformed, so that all parse errors can be detected with errors.Is
+func (err *ParseError) Is(target error) bool {
+	return target == ErrMalformed
+}
+
+// RangeError describes a value that was outside the range its type allows. The value and bound are
+// formatted as strings, and the error wraps either ErrUnderflow, if the value was less than the bound,
+// or ErrOverflow, if it was greater
+type RangeError struct {
+	Type  string
+	Value string
+	Bound string
+	Err   error
+}
+
+// Error converts the range error to a string
+func (err *RangeError) Error() string {
+	if errors.Is(err.Err, ErrUnderflow) {
+		return fmt.Sprintf("%s (%s) is less than the minimum of %s", err.Type, err.Value, err.Bound)
+	}
+
+	return fmt.Sprintf("%s (%s) is greater than the maximum of %s", err.Type, err.Value, err.Bound)
+}
+
+// Unwrap returns the error wrapped by the range error
+func (err *RangeError) Unwrap() error {
+	return err.Err
+}
+
+// SignError describes a value made up of parts that must share a sign but which have different signs. The
+// value is formatted as a string and the parts name the components that disagree. This error always wraps
+// ErrSignMismatch
+type SignError struct {
+	Type  string
+	Value string
+	Parts string
+}
+
+// Error converts the sign error to a string
+func (err *SignError) Error() string {
+	return fmt.Sprintf("%s (%s) has %s with different signs", err.Type, err.Value, err.Parts)
+}
+
+// Unwrap returns ErrSignMismatch
+func (err *SignError) Unwrap() error {
+	return ErrSignMismatch
+}
+
+// EnumError describes input that could not be converted to an enum value. It wraps ErrUnknownEnumName,
+// ErrUnknownEnumValue or ErrAmbiguousEnumName so it can be checked with errors.Is. If the name was
+// ambiguous then the names it could refer to will be included as candidates
+type EnumError struct {
+	Enum       string
+	Input      string
+	Candidates []string
+	Err        error
+}
+
+// Error converts the enum error to a string
+func (err *EnumError) Error() string {
+	switch {
+	case errors.Is(err.Err, ErrUnknownEnumValue):
+		return fmt.Sprintf("value of %s is not a valid %s", err.Input, err.Enum)
+	case errors.Is(err.Err, ErrAmbiguousEnumName):
+		return fmt.Sprintf("value of %q is ambiguous for a %s as it could refer to any of %s",
+			err.Input, err.Enum, strings.Join(quoteAll(err.Candidates), ", "))
+	default:
+		return fmt.Sprintf("value of %q cannot be mapped to a %s", err.Input, err.Enum)
+	}
+}
+
+// Unwrap returns the error wrapped by the enum error
+func (err *EnumError) Unwrap() error {
+	return err.Err
+}
+
+// TypeError describes a DynamoDB attribute value, BSON value or SQL driver value that had a type which
+// could not be converted to the requested type. The source describes where the value came from. This error
+// always wraps ErrUnsupportedType
+type TypeError struct {
+	Source string
+	Actual string
+	Type   string
+}
+
+// Error converts the type error to a string
+func (err *TypeError) Error() string {
+	return fmt.Sprintf("%s of %s could not be converted to a %s", err.Source, err.Actual, err.Type)
+}
+
+// Unwrap returns ErrUnsupportedType
+func (err *TypeError) Unwrap() error {
+	return ErrUnsupportedType
+}
+
+// NewTypeError creates a new TypeError for a value from a source that could not be converted to a type
+func NewTypeError(source string, value interface{}, typ string) *TypeError {
+	return &TypeError{Source: source, Actual: fmt.Sprintf("%T", value), Type: typ}
+}
+
+// Helper function that creates an error for a name that could not be mapped to an enum value
+func unknownName[T ~int32](name string) error {
+	var value T
+	return &EnumError{Enum: fmt.Sprintf("%T", value), Input: name, Err: ErrUnknownEnumName}
+}
+
+// Helper function that creates an error for an integer that is not a valid enum value
+func unknownValue[T ~int32](number int64) error {
+	var value T
+	return &EnumError{Enum: fmt.Sprintf("%T", value), Input: fmt.Sprintf("%d", number), Err: ErrUnknownEnumValue}
+}
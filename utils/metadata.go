@@ -0,0 +1,81 @@
+package utils
+
+import "reflect"
+
+// EnumValue contains the metadata associated with a single enum value, which can be used to present the
+// value to users or to validate input against it
+type EnumValue[T ProtoEnum] struct {
+
+	// The value itself
+	Value T
+
+	// The name of the value, as declared in its protobuf file
+	Name string
+
+	// The name written when the value is encoded, which may differ from its protobuf name
+	OutputName string
+
+	// The alternate names that will be accepted for the value when decoding, sorted alphabetically
+	Aliases []string
+
+	// A human-readable description of the value, taken from the comments in its protobuf file
+	Description string
+}
+
+// Values returns every value defined for an enum, in the order they were declared
+func Values[T ProtoEnum]() []T {
+	return codecFor[T]().Values()
+}
+
+// Names returns the protobuf names of every value defined for an enum, in the order they were declared
+func Names[T ProtoEnum]() []string {
+	return codecFor[T]().Names()
+}
+
+// Describe returns the metadata associated with an enum value. If the value is not defined for the enum
+// then only its value and output name will be set
+func Describe[T ProtoEnum](value T) EnumValue[T] {
+	return codecFor[T]().Describe(value)
+}
+
+// Enumerate returns the metadata associated with every value defined for an enum, in the order they were
+// declared
+func Enumerate[T ProtoEnum]() []EnumValue[T] {
+	codec := codecFor[T]()
+	values := codec.Values()
+	described := make([]EnumValue[T], len(values))
+	for i, value := range values {
+		described[i] = codec.Describe(value)
+	}
+
+	return described
+}
+
+// Format converts an enum value to its output name, if one exists, or its protobuf name otherwise. If the
+// value has neither then it will be formatted as an integer
+func Format[T ProtoEnum](value T) string {
+	return codecFor[T]().String(value)
+}
+
+// Parse converts a name, alternate name or integer to an enum value, in the same way as it would be
+// decoded from CSV. An error will be returned if the value cannot be mapped to the enum
+func Parse[T ProtoEnum](raw string) (T, error) {
+	var value T
+	err := codecFor[T]().DecodeCSV(raw, &value)
+	return value, err
+}
+
+// Helper function that retrieves the codec registered for an enum. If no codec has been created for the
+// enum then one will be created without any aliases or descriptions
+func codecFor[T ProtoEnum]() *EnumCodec[T] {
+	key := reflect.TypeOf(T(0))
+	if codec, ok := codecRegistry.Load(key); ok {
+		return codec.(*EnumCodec[T])
+	}
+
+	// Create a codec, which will register itself unless another was registered in the meantime, and
+	// then return whichever codec was registered
+	NewEnumCodec[T](nil, nil)
+	codec, _ := codecRegistry.Load(key)
+	return codec.(*EnumCodec[T])
+}
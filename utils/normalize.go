@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Normalizer converts a name to a normal form so that names which differ only in formatting can be
+// compared with each other
+type Normalizer func(string) string
+
+// DefaultNormalizer is the normalizer used by an EnumCodec unless another is provided. It trims whitespace,
+// folds case and strips separators and punctuation so that, for example, "CommonShare", "common_share",
+// "Common-Share" and "COMMON SHARE" are all treated as the same name
+var DefaultNormalizer = Normalize(strings.TrimSpace, FoldCase, StripSeparators, StripPunctuation)
+
+// Normalize creates a Normalizer from a pipeline of steps, which will be applied in order
+func Normalize(steps ...Normalizer) Normalizer {
+	return func(name string) string {
+		for _, step := range steps {
+			name = step(name)
+		}
+
+		return name
+	}
+}
+
+// FoldCase is a normalization step that converts a name to lower case
+func FoldCase(name string) string {
+	return strings.ToLower(name)
+}
+
+// StripSeparators is a normalization step that removes whitespace, underscores, hyphens, dots and slashes
+// from a name, so that snake case, kebab case, camel case and space-separated names are equivalent
+func StripSeparators(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '_' || r == '-' || r == '.' || r == '/' {
+			return -1
+		}
+
+		return r
+	}, name)
+}
+
+// StripPunctuation is a normalization step that removes all punctuation and symbols from a name
+func StripPunctuation(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return -1
+		}
+
+		return r
+	}, name)
+}
+
+// NameIndex allows enum values to be looked up by the normalized form of their names. If two names that
+// refer to different values have the same normal form then that form is ambiguous and will not be
+// matched; instead, the collision will be recorded so it can be reported
+type NameIndex[T ~int32] struct {
+	normalizer Normalizer
+	values     map[string]T
+	names      map[string][]string
+	ambiguous  map[string]bool
+}
+
+// NewNameIndex creates a new NameIndex from a normalizer and a number of tables mapping names to values.
+// If the normalizer is nil then the index will be empty
+func NewNameIndex[T ~int32](normalizer Normalizer, tables ...map[string]T) *NameIndex[T] {
+	index := NameIndex[T]{
+		normalizer: normalizer,
+		values:     make(map[string]T),
+		names:      make(map[string][]string),
+		ambiguous:  make(map[string]bool),
+	}
+
+	if normalizer == nil {
+		return &index
+	}
+
+	// Add each name to the index under its normal form. If a normal form was already added for a
+	// different value then it is ambiguous. The names are sorted first so that collisions are
+	// reported in the same order every time
+	for _, table := range tables {
+		names := make([]string, 0, len(table))
+		for name := range table {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+		for _, name := range names {
+			value := table[name]
+			key := normalizer(name)
+			if existing, ok := index.values[key]; ok && existing != value {
+				index.ambiguous[key] = true
+			} else if !ok {
+				index.values[key] = value
+			}
+
+			index.names[key] = append(index.names[key], name)
+		}
+	}
+
+	return &index
+}
+
+// Lookup attempts to find the value associated with the normal form of a name. This function returns
+// false if no name has the same normal form, or an error if the normal form is ambiguous
+func (index *NameIndex[T]) Lookup(name string) (T, bool, error) {
+	if index.normalizer == nil {
+		return 0, false, nil
+	}
+
+	key := index.normalizer(name)
+	if index.ambiguous[key] {
+		var value T
+		return value, false, &EnumError{Enum: fmt.Sprintf("%T", value), Input: name,
+			Candidates: index.names[key], Err: ErrAmbiguousEnumName}
+	}
+
+	value, ok := index.values[key]
+	return value, ok, nil
+}
+
+// Collisions returns an error for each normal form that was produced by names referring to different
+// values. These names can only be matched exactly
+func (index *NameIndex[T]) Collisions() []error {
+	keys := index.Ambiguous()
+	errs := make([]error, len(keys))
+	for i, key := range keys {
+		errs[i] = index.collision(key)
+	}
+
+	return errs
+}
+
+// Ambiguous returns each normal form that was produced by names referring to different values, in sorted
+// order
+func (index *NameIndex[T]) Ambiguous() []string {
+	keys := make([]string, 0, len(index.ambiguous))
+	for key := range index.ambiguous {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Helper function that creates the error describing the names that produced an ambiguous normal form
+func (index *NameIndex[T]) collision(key string) error {
+	var value T
+	return fmt.Errorf("names %s of %T all normalize to %q", strings.Join(quoteAll(index.names[key]), ", "),
+		value, key)
+}
+
+// Helper function that quotes each of a list of names
+func quoteAll(names []string) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+
+	return quoted
+}
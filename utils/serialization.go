@@ -3,8 +3,12 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // None is an empty map that can be used in place of alternate values for the unmarshaller
@@ -13,6 +17,45 @@ var None = make(map[string]int32)
 // Ignore is an empty map that can be used in place of alternate values for the marshaller
 var Ignore = make(map[int32]string)
 
+// DecodingMode determines whether integers are validated against the values defined for an enum when
+// they are decoded
+type DecodingMode int32
+
+const (
+	// DefaultDecoding indicates that the global decoding mode should be used
+	DefaultDecoding DecodingMode = iota
+
+	// LenientDecoding accepts any integer that fits in an enum, whether or not it is a defined value
+	LenientDecoding
+
+	// StrictDecoding rejects any integer that is not a defined value of the enum with ErrUnknownEnumValue
+	StrictDecoding
+)
+
+// The global decoding mode, used by any decoder that hasn't been set to a decoding mode of its own
+var decodingMode int32 = int32(LenientDecoding)
+
+// SetDecodingMode sets the decoding mode used by UnmarshalValue, UnmarshalString, ScanValue and any
+// EnumCodec that hasn't been given a decoding mode of its own. Setting the mode to DefaultDecoding will
+// restore lenient decoding
+func SetDecodingMode(mode DecodingMode) {
+	if mode == DefaultDecoding {
+		mode = LenientDecoding
+	}
+
+	atomic.StoreInt32(&decodingMode, int32(mode))
+}
+
+// IsStrict returns true if the decoding mode provided, or the global decoding mode if the mode is
+// DefaultDecoding, requires integers to be validated
+func IsStrict(mode DecodingMode) bool {
+	if mode == DefaultDecoding {
+		mode = DecodingMode(atomic.LoadInt32(&decodingMode))
+	}
+
+	return mode == StrictDecoding
+}
+
 // NoValue returns the equivalent of no-value for one of the common financial enums. This function can
 // be used when the user wants to deactivate a filter that relies on an enum message type
 func NoValue[TEnum ~int32]() TEnum {
@@ -43,31 +86,49 @@ func MarshalString[TIn ~int32, TMap ~int32, TAlt ~int32](data TIn, mapping map[T
 	}
 }
 
-// UnmarshalValue converts JSON to a protobuf enum value
+// UnmarshalValue converts JSON to a protobuf enum value. If the global decoding mode is strict then
+// integers that aren't defined values of the enum will be rejected
 func UnmarshalValue[TMap ~int32, TAlt ~int32, TOut ~int32](raw []byte, mapping map[string]TMap,
 	alternates map[string]TAlt, data *TOut) error {
 
 	// Attempt to deserialize the value to a string to remove any escapes or
-	// quotes that aren't needed; if this fails then return an error. If the
-	// string isn't already quoted then we probably don't have any work to do
-	// here so just trim the whitespace off and set it directly
+	// quotes that aren't needed; if this fails then return an error
+	asStr, err := unquote(raw)
+	if err != nil {
+		return err
+	}
+
+	// Convert the value from a string to its enum equivalent and return the result
+	return UnmarshalString(asStr, mapping, alternates, data)
+}
+
+// Helper function that removes the quotes and escapes from a JSON string. If the string isn't
+// already quoted then we probably don't have any work to do here so just return it directly
+func unquote(raw []byte) (string, error) {
 	var asStr string
 	if runes := []rune(string(raw)); len(runes) >= 2 && runes[0] == '"' && runes[len(runes)-1] == '"' {
 		if err := json.Unmarshal(raw, &asStr); err != nil {
-			return err
+			return "", err
 		}
 	} else {
 		asStr = string(raw)
 	}
 
-	// Convert the value from a string to its enum equivalent and return the result
-	return UnmarshalString(asStr, mapping, alternates, data)
+	return asStr, nil
 }
 
 // ScanValue is intended to be used by functions that want to implement the Scanner
-// interface for converting SQL values to enums
+// interface for converting SQL values to enums. If the global decoding mode is strict then
+// integers that aren't defined values of the enum will be rejected
 func ScanValue[TMap ~int32, TAlt ~int32, TOut ~int32](value interface{}, mapping map[string]TMap,
 	alternates map[string]TAlt, data *TOut) error {
+	return scanValue(value, mapping, alternates, data, definedIn[TOut](IsStrict(DefaultDecoding), mapping, alternates))
+}
+
+// Helper function that converts an SQL value to an enum value. If the validation function is
+// not nil then it will be called to verify any integer
+func scanValue[TMap ~int32, TAlt ~int32, TOut ~int32](value interface{}, mapping map[string]TMap,
+	alternates map[string]TAlt, data *TOut, valid func(int32) bool) error {
 
 	// The type of the interface will define how we translate the value
 	switch casted := value.(type) {
@@ -81,30 +142,85 @@ func ScanValue[TMap ~int32, TAlt ~int32, TOut ~int32](value interface{}, mapping
 		} else if inner, ok := alternates[casted]; ok {
 			*data = TOut(inner)
 		} else {
-			return fmt.Errorf("value of %v cannot be mapped to a %T", casted, *data)
+			return unknownName[TOut](casted)
 		}
 
 	// We have an integer value so cast it to a 32-bit integer
 	case int:
-		*data = TOut(int32(casted))
+		return scanInteger(int64(casted), data, valid)
 	case int16:
-		*data = TOut(int32(casted))
+		return scanInteger(int64(casted), data, valid)
 	case int32:
-		*data = TOut(casted)
+		return scanInteger(int64(casted), data, valid)
 	case int64:
-		*data = TOut(int32(casted))
+		return scanInteger(casted, data, valid)
 
 	// We aren't sure what type this is so throw an error
 	default:
-		return fmt.Errorf("value of %q had an invalid type of %T", casted, casted)
+		var zero TOut
+		return NewTypeError("Driver value", casted, fmt.Sprintf("%T", zero))
+	}
+
+	return nil
+}
+
+// Helper function that converts an integer to an enum value. Integers that don't fit in an enum will
+// always be rejected; any others will be checked with the validation function, if it isn't nil
+func scanInteger[TOut ~int32](value int64, data *TOut, valid func(int32) bool) error {
+	if value < math.MinInt32 || value > math.MaxInt32 {
+		return unknownValue[TOut](value)
+	} else if valid != nil && !valid(int32(value)) {
+		return unknownValue[TOut](value)
 	}
 
+	*data = TOut(int32(value))
 	return nil
 }
 
-// UnmarshalString unmarshals a value from a string into an enum value
+// Helper function that creates a function to check whether an integer is a defined value of an enum. If
+// the enum is a protobuf enum then its descriptor will be used; otherwise, the integer must be one of the
+// values contained in the mapping or its alternates. If the check isn't required then this function
+// returns nil
+func definedIn[TOut ~int32, TMap ~int32, TAlt ~int32](strict bool, mapping map[string]TMap,
+	alternates map[string]TAlt) func(int32) bool {
+	if !strict {
+		return nil
+	}
+
+	// If the enum has a descriptor then check the integer against the values it defines
+	if enum, ok := any(TOut(0)).(protoreflect.Enum); ok {
+		values := enum.Descriptor().Values()
+		return func(value int32) bool {
+			return values.ByNumber(protoreflect.EnumNumber(value)) != nil
+		}
+	}
+
+	// Otherwise, collect the values from the mapping and alternates once so each check is a lookup
+	defined := make(map[int32]bool, len(mapping)+len(alternates))
+	for _, inner := range mapping {
+		defined[int32(inner)] = true
+	}
+
+	for _, inner := range alternates {
+		defined[int32(inner)] = true
+	}
+
+	return func(value int32) bool {
+		return defined[value]
+	}
+}
+
+// UnmarshalString unmarshals a value from a string into an enum value. If the global decoding mode
+// is strict then integers that aren't defined values of the enum will be rejected
 func UnmarshalString[TMap ~int32, TAlt ~int32, TOut ~int32](value string, mapping map[string]TMap,
 	alternates map[string]TAlt, data *TOut) error {
+	return unmarshalString(value, mapping, alternates, data, definedIn[TOut](IsStrict(DefaultDecoding), mapping, alternates))
+}
+
+// Helper function that converts a string to an enum value. If the validation function is not nil
+// then it will be called to verify any integer that doesn't appear in the mapping or alternates
+func unmarshalString[TMap ~int32, TAlt ~int32, TOut ~int32](value string, mapping map[string]TMap,
+	alternates map[string]TAlt, data *TOut, valid func(int32) bool) error {
 
 	// First, trim all whitespace from the value
 	asStr := strings.TrimSpace(value)
@@ -121,11 +237,15 @@ func UnmarshalString[TMap ~int32, TAlt ~int32, TOut ~int32](value string, mappin
 
 	// Finally, the value didn't map to one of the mapping options so attempt
 	// to cover it to an integer directly; if this fails then return an error
-	if value, err := strconv.ParseInt(asStr, 10, 32); err == nil {
-		*data = TOut(int32(value))
-	} else {
-		return fmt.Errorf("value of %q cannot be mapped to a %T", asStr, *data)
+	number, err := strconv.ParseInt(asStr, 10, 64)
+	if err != nil {
+		return unknownName[TOut](asStr)
+	} else if number < math.MinInt32 || number > math.MaxInt32 {
+		return unknownValue[TOut](number)
+	} else if valid != nil && !valid(int32(number)) {
+		return unknownValue[TOut](number)
 	}
 
+	*data = TOut(int32(number))
 	return nil
 }